@@ -0,0 +1,109 @@
+package clientlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Authenticator adds credentials to every request sent to the management API.
+// Authenticate is called before each attempt, so implementations can refresh
+// expired credentials between retries.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// WithAuthenticator replaces the basic authentication of the Client.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// BasicAuth authenticates requests with a username and password.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+func (a BasicAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// BearerToken authenticates requests with a static bearer token, e.g. a JWT
+// issued outside of Terraform.
+type BearerToken struct {
+	Token string
+}
+
+func (a BearerToken) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// OAuth2ClientCredentialsConfig configures the OAuth2 client credentials flow.
+type OAuth2ClientCredentialsConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// EndpointParams are additional parameters sent to the token endpoint,
+	// e.g. audience.
+	EndpointParams map[string]string
+}
+
+// OAuth2ClientCredentials authenticates requests with an access token fetched
+// through the OAuth2 client credentials flow. The token is cached and fetched
+// again shortly before it expires.
+type OAuth2ClientCredentials struct {
+	config     clientcredentials.Config
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// NewOAuth2ClientCredentials returns an authenticator for the client
+// credentials flow. Token requests are sent with httpClient, so they share the
+// TLS configuration of the API requests.
+func NewOAuth2ClientCredentials(config OAuth2ClientCredentialsConfig, httpClient *http.Client) *OAuth2ClientCredentials {
+	params := url.Values{}
+	for key, value := range config.EndpointParams {
+		params.Set(key, value)
+	}
+	return &OAuth2ClientCredentials{
+		config: clientcredentials.Config{
+			ClientID:       config.ClientID,
+			ClientSecret:   config.ClientSecret,
+			TokenURL:       config.TokenURL,
+			Scopes:         config.Scopes,
+			EndpointParams: params,
+		},
+		httpClient: httpClient,
+	}
+}
+
+func (a *OAuth2ClientCredentials) Authenticate(ctx context.Context, req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.token.Valid() {
+		if a.httpClient != nil {
+			ctx = context.WithValue(ctx, oauth2.HTTPClient, a.httpClient)
+		}
+		token, err := a.config.Token(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch OAuth2 access token: %w", err)
+		}
+		a.token = token
+	}
+
+	a.token.SetAuthHeader(req)
+	return nil
+}
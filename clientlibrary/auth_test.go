@@ -0,0 +1,166 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newAuthTestServer returns a server that records the Authorization header of
+// the last request.
+func newAuthTestServer(t *testing.T, header *atomic.Value) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header.Store(r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestBasicAuth(t *testing.T) {
+	var header atomic.Value
+	server := newAuthTestServer(t, &header)
+	client := NewClient(server.URL, "test", "guest", "secret", server.Client())
+
+	if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.SetBasicAuth("guest", "secret")
+	if got, want := header.Load(), req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	var header atomic.Value
+	server := newAuthTestServer(t, &header)
+	client := NewClient(server.URL, "test", "", "", server.Client(), WithAuthenticator(BearerToken{Token: "static-token"}))
+
+	if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := header.Load(); got != "Bearer static-token" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer static-token")
+	}
+}
+
+// newTokenServer returns a fake OAuth2 token endpoint issuing numbered tokens
+// that expire after expiresIn seconds.
+func newTokenServer(t *testing.T, expiresIn int, issued *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse token request: %v", err)
+		}
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "terraform" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		if got := r.Form.Get("grant_type"); got != "client_credentials" {
+			t.Errorf("grant_type = %q, want client_credentials", got)
+		}
+		if got := r.Form.Get("scope"); got != "lavinmq.configure:*/* lavinmq.tag:administrator" {
+			t.Errorf("scope = %q", got)
+		}
+		if got := r.Form.Get("audience"); got != "lavinmq" {
+			t.Errorf("audience = %q, want lavinmq", got)
+		}
+
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestOAuth2Config(tokenURL, clientSecret string) OAuth2ClientCredentialsConfig {
+	return OAuth2ClientCredentialsConfig{
+		TokenURL:       tokenURL,
+		ClientID:       "terraform",
+		ClientSecret:   clientSecret,
+		Scopes:         []string{"lavinmq.configure:*/*", "lavinmq.tag:administrator"},
+		EndpointParams: map[string]string{"audience": "lavinmq"},
+	}
+}
+
+func TestOAuth2ClientCredentialsCachesToken(t *testing.T) {
+	var issued atomic.Int32
+	tokenServer := newTokenServer(t, 3600, &issued)
+	var header atomic.Value
+	server := newAuthTestServer(t, &header)
+
+	auth := NewOAuth2ClientCredentials(newTestOAuth2Config(tokenServer.URL, "secret"), tokenServer.Client())
+	client := NewClient(server.URL, "test", "", "", server.Client(), WithAuthenticator(auth))
+
+	for range 3 {
+		if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := header.Load(); got != "Bearer token-1" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer token-1")
+	}
+	if got := issued.Load(); got != 1 {
+		t.Errorf("issued tokens = %d, want 1", got)
+	}
+}
+
+func TestOAuth2ClientCredentialsRefreshesExpiredToken(t *testing.T) {
+	var issued atomic.Int32
+	// Tokens expiring within the oauth2 expiry delta are never considered
+	// valid, so every request fetches a new one.
+	tokenServer := newTokenServer(t, 1, &issued)
+	var header atomic.Value
+	server := newAuthTestServer(t, &header)
+
+	auth := NewOAuth2ClientCredentials(newTestOAuth2Config(tokenServer.URL, "secret"), tokenServer.Client())
+	client := NewClient(server.URL, "test", "", "", server.Client(), WithAuthenticator(auth))
+
+	for range 2 {
+		if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := header.Load(); got != "Bearer token-2" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer token-2")
+	}
+	if got := issued.Load(); got != 2 {
+		t.Errorf("issued tokens = %d, want 2", got)
+	}
+}
+
+func TestOAuth2ClientCredentialsTokenError(t *testing.T) {
+	var issued atomic.Int32
+	tokenServer := newTokenServer(t, 3600, &issued)
+	var apiRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiRequests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	auth := NewOAuth2ClientCredentials(newTestOAuth2Config(tokenServer.URL, "wrong"), tokenServer.Client())
+	client := NewClient(server.URL, "test", "", "", server.Client(), WithAuthenticator(auth))
+
+	_, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Errorf("error = %v, want error containing invalid_client", err)
+	}
+	if got := apiRequests.Load(); got != 0 {
+		t.Errorf("API requests = %d, want 0", got)
+	}
+}
@@ -2,7 +2,7 @@ package clientlibrary
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -57,14 +57,11 @@ func (s *BindingsService) Get(ctx context.Context, vhost, source, destination, d
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result *BindingResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	return result, err
 }
 
@@ -74,17 +71,17 @@ func (s *BindingsService) List(ctx context.Context, vhost string) ([]BindingResp
 		path = fmt.Sprintf("api/bindings/%s", url.PathEscape(vhost))
 	}
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []BindingResponse{}, nil
+	}
 	if err != nil {
 		return []BindingResponse{}, err
 	}
-	if resp == nil {
-		return []BindingResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result []BindingResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return []BindingResponse{}, err
 	}
@@ -104,5 +101,5 @@ func (s *BindingsService) Delete(ctx context.Context, vhost, source, destination
 		path = fmt.Sprintf("api/bindings/%s/e/%s/e/%s/%s", vhost, source, destination, propertiesKey)
 	}
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -8,15 +8,69 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
 )
 
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	userAgent  string
+	httpClient  *http.Client
+	baseURL     string
+	userAgent   string
+	retryPolicy RetryPolicy
+	auth        Authenticator
+	// requestTimeout bounds every attempt of a request, zero means no bound
+	// other than the request context.
+	requestTimeout time.Duration
+	// limiter and inFlight throttle the requests sent to the API, nil means
+	// unlimited.
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+	// headers are added to every request.
+	headers http.Header
+	// protectNonEmptyQueues makes QueuesService.Delete refuse to delete
+	// queues that contain messages.
+	protectNonEmptyQueues bool
+}
+
+// ClientOption configures optional behaviour of the Client.
+type ClientOption func(*Client)
+
+// WithRetryPolicy overrides the default retry policy of the Client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRequestTimeout bounds the duration of every attempt of a request,
+// including reading the response body. Attempts that time out are retried
+// according to the retry policy.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithHeaders adds the headers to every request, e.g. for routing by a proxy
+// in front of the API. Headers set by the client itself, such as Accept,
+// Content-Type, User-Agent and Authorization, take precedence.
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		c.headers = make(http.Header, len(headers))
+		for name, value := range headers {
+			c.headers.Set(name, value)
+		}
+	}
+}
 
-	username string
-	password string
+// WithNonEmptyQueueProtection makes QueuesService.Delete refuse to delete
+// queues that contain messages, with a QueueNotEmptyError.
+func WithNonEmptyQueueProtection() ClientOption {
+	return func(c *Client) {
+		c.protectNonEmptyQueues = true
+	}
 }
 
 type service struct {
@@ -28,14 +82,18 @@ type ErrorResponse struct {
 	Reason string `json:"reason"`
 }
 
-func NewClient(baseURL, useragent, username, password string, httpClient *http.Client) *Client {
-	return &Client{
-		baseURL:    baseURL,
-		userAgent:  useragent,
-		httpClient: httpClient,
-		username:   username,
-		password:   password,
+func NewClient(baseURL, useragent, username, password string, httpClient *http.Client, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:     baseURL,
+		userAgent:   useragent,
+		httpClient:  withLogging(httpClient),
+		retryPolicy: DefaultRetryPolicy(),
+		auth:        BasicAuth{Username: username, Password: password},
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *Client) NewRequest(method, path string, body any) (*http.Request, error) {
@@ -63,11 +121,14 @@ func (c *Client) NewRequest(method, path string, body any) (*http.Request, error
 		return nil, err
 	}
 
+	for name, values := range c.headers {
+		req.Header[name] = append([]string(nil), values...)
+	}
+
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	req.SetBasicAuth(c.username, c.password)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.userAgent)
 	return req, nil
@@ -75,28 +136,145 @@ func (c *Client) NewRequest(method, path string, body any) (*http.Request, error
 
 func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	req = req.WithContext(ctx)
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(ctx, req)
 	if err != nil {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
 		return nil, err
 	}
 
 	switch resp.StatusCode {
 	case 200, 201, 204:
 		return resp, nil
-	case 404:
-		return nil, nil
 	default:
 		defer resp.Body.Close()
 		body, _ := io.ReadAll(resp.Body)
 		var errorBody ErrorResponse
 		_ = json.Unmarshal(body, &errorBody)
-		return nil, fmt.Errorf("status code: %d, error: %s", resp.StatusCode, errorBody.Reason)
+		return nil, &APIError{
+			Method:     req.Method,
+			Path:       req.URL.Path,
+			StatusCode: resp.StatusCode,
+			ErrorType:  errorBody.Error,
+			Reason:     errorBody.Reason,
+			Body:       body,
+		}
+	}
+}
+
+// doWithRetry sends the request and retries transport errors and retryable
+// status codes according to the retry policy of the client. The last response
+// or error is returned once the request succeeds or retries are exhausted.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if err := c.auth.Authenticate(ctx, req); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.doAttempt(ctx, req)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+			if !c.retryPolicy.retryableError(req.Method, err) {
+				return nil, err
+			}
+		} else if !c.retryPolicy.retryableStatus(req.Method, resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait, ok := c.nextRetry(req, attempt, start, resp)
+		if !ok {
+			return resp, err
+		}
+
+		fields := map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// doAttempt sends a single attempt of the request once the rate limiter and
+// the in-flight cap allow it. The attempt is bounded by the request timeout of
+// the client, which also covers reading the response body and is released
+// when the body is closed.
+func (c *Client) doAttempt(ctx context.Context, req *http.Request) (*http.Response, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if c.requestTimeout <= 0 {
+		return c.httpClient.Do(req)
 	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	resp, err := c.httpClient.Do(req.WithContext(attemptCtx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody releases the context of an attempt when the response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// nextRetry returns the wait before the next attempt, or false when the
+// request should not be retried again.
+func (c *Client) nextRetry(req *http.Request, attempt int, start time.Time, resp *http.Response) (time.Duration, bool) {
+	if attempt >= c.retryPolicy.MaxAttempts {
+		return 0, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return 0, false
+	}
+
+	wait := c.retryPolicy.backoff(attempt, resp)
+	if c.retryPolicy.MaxElapsedTime > 0 && time.Since(start)+wait > c.retryPolicy.MaxElapsedTime {
+		return 0, false
+	}
+	return wait, true
 }
 
 func (c *Client) Request(ctx context.Context, method, path string, body any) (*http.Response, error) {
@@ -106,3 +284,11 @@ func (c *Client) Request(ctx context.Context, method, path string, body any) (*h
 	}
 	return c.Do(ctx, req)
 }
+
+// unmarshalJSON decodes a response body like json.Unmarshal, but decodes the
+// numbers in arguments and definitions as json.Number to keep their precision.
+func unmarshalJSON(body []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
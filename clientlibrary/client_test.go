@@ -0,0 +1,350 @@
+package clientlibrary
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewClient(server.URL, "test", "guest", "guest", server.Client(), opts...)
+}
+
+func TestDoRetriesRetryableStatus(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestDoResendsBodyOnRetry(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"pattern":"^amq\\."`) {
+			t.Errorf("attempt %d: unexpected body %q", attempts.Load()+1, body)
+		}
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	request := PolicyRequest{Pattern: `^amq\.`, Definition: map[string]any{"max-length": 10}}
+	if _, err := client.Request(context.Background(), http.MethodPut, "api/policies/%2F/test", request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestDoStopsAfterMaxAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":"unavailable","reason":"broker is starting"}`))
+	}, WithRetryPolicy(policy))
+
+	_, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "broker is starting") {
+		t.Errorf("error %q does not contain the broker reason", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestDoDoesNotRetryNonRetryableStatus(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	if _, err := client.Request(context.Background(), http.MethodPut, "api/vhosts/test", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestDoDoesNotRetryNonIdempotentGatewayError(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	if _, err := client.Request(context.Background(), http.MethodPost, "api/exchanges/%2F/amq.default/publish", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestDoHonoursRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	start := time.Now()
+	if _, err := client.Request(context.Background(), http.MethodPost, "api/exchanges/%2F/amq.default/publish", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("elapsed = %s, want at least the Retry-After of 1s", elapsed)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestDoStopsAtMaxElapsedTime(t *testing.T) {
+	var attempts atomic.Int32
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 10
+	policy.MaxElapsedTime = 500 * time.Millisecond
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(policy))
+
+	start := time.Now()
+	if _, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if elapsed := time.Since(start); elapsed > policy.MaxElapsedTime {
+		t.Errorf("elapsed = %s, want less than %s", elapsed, policy.MaxElapsedTime)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDoRetriesConnectionRefused(t *testing.T) {
+	var attempts atomic.Int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	})
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	client := NewClient("http://localhost:15672", "test", "guest", "guest", &http.Client{Transport: transport}, WithRetryPolicy(policy))
+
+	// Connection refused is retried even for non-idempotent requests since
+	// the request never reached the broker.
+	if _, err := client.Request(context.Background(), http.MethodPost, "api/exchanges/%2F/amq.default/publish", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestDoDoesNotRetryPermanentTLSErrors(t *testing.T) {
+	var connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	// The default HTTP client does not trust the certificate of the server.
+	client := NewClient(server.URL, "test", "guest", "guest", &http.Client{}, WithRetryPolicy(testRetryPolicy()))
+	_, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	if !errors.As(err, &unknownAuthorityErr) {
+		t.Fatalf("error = %v, want x509.UnknownAuthorityError", err)
+	}
+	if got := connections.Load(); got != 1 {
+		t.Errorf("connections = %d, want 1", got)
+	}
+}
+
+func TestRetryableErrorTLS(t *testing.T) {
+	policy := testRetryPolicy()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"unknown authority", &url.Error{Op: "Get", Err: x509.UnknownAuthorityError{}}, false},
+		{"hostname mismatch", &url.Error{Op: "Get", Err: x509.HostnameError{Host: "lavinmq"}}, false},
+		{"certificate verification", &url.Error{Op: "Get", Err: &tls.CertificateVerificationError{Err: errors.New("expired")}}, false},
+		{"record header", &url.Error{Op: "Get", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}}, false},
+		{"connection reset", &url.Error{Op: "Get", Err: syscall.ECONNRESET}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.retryableError(http.MethodGet, tt.err); got != tt.want {
+				t.Errorf("retryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoStopsRetryingWhenContextIsCancelled(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MinBackoff = time.Minute
+	policy.MaxBackoff = time.Minute
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Request(ctx, http.MethodGet, "api/vhosts", nil); err != context.DeadlineExceeded {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	tests := []struct {
+		retry    int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		for range 20 {
+			got := policy.backoff(tt.retry, nil)
+			if got < tt.min || got > tt.max {
+				t.Errorf("backoff(%d) = %s, want between %s and %s", tt.retry, got, tt.min, tt.max)
+			}
+		}
+	}
+}
+
+func TestDoRequestTimeoutRetriesHungAttempt(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"/"}`))
+	}, WithRetryPolicy(testRetryPolicy()), WithRequestTimeout(50*time.Millisecond))
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts/%2F", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The timeout of the attempt is released when the body is closed, not when
+	// Do returns, so the body can still be read.
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+	if string(body) != `{"name":"/"}` {
+		t.Errorf("body = %q", body)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestDoRequestTimeoutExhaustsAttempts(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 2
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}, WithRetryPolicy(policy), WithRequestTimeout(20*time.Millisecond))
+
+	_, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,69 @@
+package clientlibrary
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matched by APIError through errors.Is. The Get methods of
+// the services return an error matching ErrNotFound when the object does not
+// exist, List methods return an empty list and Delete methods return nil.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrServerError  = errors.New("server error")
+)
+
+// APIError is returned by Client.Do when the API responds with an unexpected
+// status code.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	// ErrorType and Reason are decoded from the ErrorResponse body, if any.
+	ErrorType string
+	Reason    string
+	Body      []byte
+}
+
+func (e *APIError) Error() string {
+	reason := e.Reason
+	if reason == "" {
+		reason = e.ErrorType
+	}
+	return fmt.Sprintf("%s %s failed, status code: %d, error: %s", e.Method, e.Path, e.StatusCode, reason)
+}
+
+// Is reports whether the status code of the error corresponds to one of the
+// sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
+
+// ignoreNotFound drops ErrNotFound from the error of a delete request, an
+// object that does not exist is already deleted.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
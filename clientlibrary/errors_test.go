@@ -0,0 +1,78 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDoReturnsAPIError(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		sentinel   error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusInternalServerError, ErrServerError},
+	}
+	sentinels := []error{ErrBadRequest, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrServerError}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			policy := testRetryPolicy()
+			policy.MaxAttempts = 1
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{"error":"some_error","reason":"some reason"}`))
+			}, WithRetryPolicy(policy))
+
+			_, err := client.Request(context.Background(), http.MethodPut, "api/vhosts/test", nil)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want *APIError", err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.statusCode)
+			}
+			if apiErr.Method != http.MethodPut || apiErr.Path != "/api/vhosts/test" {
+				t.Errorf("request = %s %s, want PUT /api/vhosts/test", apiErr.Method, apiErr.Path)
+			}
+			if apiErr.ErrorType != "some_error" || apiErr.Reason != "some reason" {
+				t.Errorf("ErrorType, Reason = %q, %q, want %q, %q", apiErr.ErrorType, apiErr.Reason, "some_error", "some reason")
+			}
+			if string(apiErr.Body) != `{"error":"some_error","reason":"some reason"}` {
+				t.Errorf("Body = %q", apiErr.Body)
+			}
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.sentinel) {
+					t.Errorf("errors.Is(err, %v) = %t", sentinel, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	tests := []struct {
+		err  APIError
+		want string
+	}{
+		{
+			APIError{Method: "PUT", Path: "/api/vhosts/test", StatusCode: 400, ErrorType: "bad_request", Reason: "invalid name"},
+			"PUT /api/vhosts/test failed, status code: 400, error: invalid name",
+		},
+		{
+			APIError{Method: "GET", Path: "/api/vhosts", StatusCode: 401, ErrorType: "not_authorized"},
+			"GET /api/vhosts failed, status code: 401, error: not_authorized",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -2,7 +2,7 @@ package clientlibrary
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -46,14 +46,11 @@ func (s *ExchangesService) Get(ctx context.Context, vhost, name string) (*Exchan
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result *ExchangeResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	return result, err
 }
 
@@ -63,17 +60,17 @@ func (s *ExchangesService) List(ctx context.Context, vhost string) ([]ExchangeRe
 		path = fmt.Sprintf("api/exchanges/%s", url.PathEscape(vhost))
 	}
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []ExchangeResponse{}, nil
+	}
 	if err != nil {
 		return []ExchangeResponse{}, err
 	}
-	if resp == nil {
-		return []ExchangeResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result []ExchangeResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return []ExchangeResponse{}, err
 	}
@@ -83,5 +80,5 @@ func (s *ExchangesService) List(ctx context.Context, vhost string) ([]ExchangeRe
 func (s *ExchangesService) Delete(ctx context.Context, vhost, name string) error {
 	path := fmt.Sprintf("api/exchanges/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -0,0 +1,52 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type FederationLinksService service
+
+// FederationLinkResponse is the status of a running federation link. Status
+// is one of starting, running, shutdown or error, Error holds the reason when
+// the link failed.
+type FederationLinkResponse struct {
+	Upstream         string `json:"upstream"`
+	Vhost            string `json:"vhost"`
+	Type             string `json:"type"`
+	Exchange         string `json:"exchange"`
+	UpstreamExchange string `json:"upstream_exchange"`
+	Queue            string `json:"queue"`
+	UpstreamQueue    string `json:"upstream_queue"`
+	Status           string `json:"status"`
+	Error            string `json:"error"`
+}
+
+func (s *FederationLinksService) List(ctx context.Context, vhost string) ([]FederationLinkResponse, error) {
+	path := "api/federation-links"
+	if vhost != "" {
+		path = fmt.Sprintf("api/federation-links/%s", url.PathEscape(vhost))
+	}
+
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []FederationLinkResponse{}, nil
+	}
+	if err != nil {
+		return []FederationLinkResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []FederationLinkResponse
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return []FederationLinkResponse{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type GlobalParametersService service
+
+type GlobalParameterResponse struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+func (s *GlobalParametersService) CreateOrUpdate(ctx context.Context, name string, request ParameterRequest) error {
+	path := fmt.Sprintf("api/global-parameters/%s", url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodPut, path, request)
+	return err
+}
+
+func (s *GlobalParametersService) Get(ctx context.Context, name string) (*GlobalParameterResponse, error) {
+	path := fmt.Sprintf("api/global-parameters/%s", url.PathEscape(name))
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result *GlobalParameterResponse
+	err = unmarshalJSON(body, &result)
+	return result, err
+}
+
+func (s *GlobalParametersService) List(ctx context.Context) ([]GlobalParameterResponse, error) {
+	resp, err := s.client.Request(ctx, http.MethodGet, "api/global-parameters", nil)
+	if errors.Is(err, ErrNotFound) {
+		return []GlobalParameterResponse{}, nil
+	}
+	if err != nil {
+		return []GlobalParameterResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []GlobalParameterResponse
+	err = unmarshalJSON(body, &result)
+	if err != nil {
+		return []GlobalParameterResponse{}, err
+	}
+	return result, nil
+}
+
+func (s *GlobalParametersService) Delete(ctx context.Context, name string) error {
+	path := fmt.Sprintf("api/global-parameters/%s", url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
+	return ignoreNotFound(err)
+}
@@ -0,0 +1,42 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGlobalParametersKeepLargeIntegers(t *testing.T) {
+	var requestBody string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			requestBody = strings.TrimSpace(string(body))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"limits","value":{"max":9007199254740993}}`))
+	})
+	services := NewServices(client)
+	ctx := context.Background()
+
+	request := ParameterRequest{Value: json.RawMessage(`{"max": 9007199254740993}`)}
+	if err := services.GlobalParameters.CreateOrUpdate(ctx, "limits", request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"value":{"max":9007199254740993}}`; requestBody != want {
+		t.Errorf("request body = %s, want %s", requestBody, want)
+	}
+
+	parameter, err := services.GlobalParameters.Get(ctx, "limits")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	value, _ := json.Marshal(parameter.Value)
+	if want := `{"max":9007199254740993}`; string(value) != want {
+		t.Errorf("value = %s, want %s", value, want)
+	}
+}
@@ -0,0 +1,137 @@
+package clientlibrary
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem of the HTTP calls to the API. Its level
+// can be set separately with the TF_LOG_PROVIDER_LAVINMQ_API environment
+// variable.
+const logSubsystem = "api"
+
+// maxLoggedBodySize bounds the part of a response body kept for the TRACE log.
+const maxLoggedBodySize = 64 << 10
+
+// loggingTransport logs every HTTP call to the API: method, path, status and
+// latency at DEBUG level, headers and bodies at TRACE level. Credentials are
+// always redacted before they are logged. Response bodies are logged as they
+// are read by the caller, so they are still streamed.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// withLogging returns a copy of httpClient that logs the calls made through
+// its transport.
+func withLogging(httpClient *http.Client) *http.Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	clone := *httpClient
+	clone.Transport = &loggingTransport{next: next}
+	return &clone
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_LAVINMQ", "API"))
+	fields := map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			body.Close()
+			tflog.SubsystemTrace(ctx, logSubsystem, "Sending request", withFields(fields, map[string]any{
+				"headers": RedactHeaders(req.Header),
+				"body":    RedactSecrets(string(content)),
+			}))
+		}
+	} else {
+		tflog.SubsystemTrace(ctx, logSubsystem, "Sending request", withFields(fields, map[string]any{
+			"headers": RedactHeaders(req.Header),
+		}))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+	if err != nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Request failed", withFields(fields, map[string]any{
+			"error": err.Error(),
+		}))
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received response", fields)
+
+	resp.Body = &loggingBody{
+		ReadCloser: resp.Body,
+		ctx:        ctx,
+		fields:     fields,
+		headers:    resp.Header,
+	}
+	return resp, nil
+}
+
+// loggingBody logs the part of a response body read by the caller at TRACE
+// level, once it is read to the end or closed.
+type loggingBody struct {
+	io.ReadCloser
+	ctx       context.Context
+	fields    map[string]any
+	headers   http.Header
+	content   bytes.Buffer
+	truncated bool
+	once      sync.Once
+}
+
+func (b *loggingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if keep := min(n, maxLoggedBodySize-b.content.Len()); keep < n {
+		b.content.Write(p[:keep])
+		b.truncated = true
+	} else {
+		b.content.Write(p[:n])
+	}
+	if err == io.EOF {
+		b.log()
+	}
+	return n, err
+}
+
+func (b *loggingBody) Close() error {
+	b.log()
+	return b.ReadCloser.Close()
+}
+
+func (b *loggingBody) log() {
+	b.once.Do(func() {
+		tflog.SubsystemTrace(b.ctx, logSubsystem, "Response body", withFields(b.fields, map[string]any{
+			"headers":   RedactHeaders(b.headers),
+			"body":      RedactSecrets(b.content.String()),
+			"truncated": b.truncated,
+		}))
+	})
+}
+
+// withFields returns the union of the two sets of log fields.
+func withFields(fields, additional map[string]any) map[string]any {
+	merged := make(map[string]any, len(fields)+len(additional))
+	maps.Copy(merged, fields)
+	maps.Copy(merged, additional)
+	return merged
+}
@@ -0,0 +1,200 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func newNotFoundServices(t *testing.T) *Services {
+	t.Helper()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not_found","reason":"Object Not Found"}`))
+	}, WithRetryPolicy(testRetryPolicy()))
+	return NewServices(client)
+}
+
+func TestGetReturnsErrNotFound(t *testing.T) {
+	services := newNotFoundServices(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		get  func() error
+	}{
+		{"Bindings", func() error {
+			_, err := services.Bindings.Get(ctx, "vhost", "source", "destination", "q", "~")
+			return err
+		}},
+		{"Exchanges", func() error {
+			_, err := services.Exchanges.Get(ctx, "vhost", "name")
+			return err
+		}},
+		{"GlobalParameters", func() error {
+			_, err := services.GlobalParameters.Get(ctx, "name")
+			return err
+		}},
+		{"OperatorPolicies", func() error {
+			_, err := services.OperatorPolicies.Get(ctx, "vhost", "name")
+			return err
+		}},
+		{"Parameters", func() error {
+			_, err := services.Parameters.Get(ctx, "shovel", "vhost", "name")
+			return err
+		}},
+		{"Permissions", func() error {
+			_, err := services.Permissions.Get(ctx, "vhost", "user")
+			return err
+		}},
+		{"Policies", func() error {
+			_, err := services.Policies.Get(ctx, "vhost", "name")
+			return err
+		}},
+		{"Queues", func() error {
+			_, err := services.Queues.Get(ctx, "vhost", "name")
+			return err
+		}},
+		{"Shovels", func() error {
+			_, err := services.Shovels.Get(ctx, "vhost", "name")
+			return err
+		}},
+		{"TopicPermissions", func() error {
+			_, err := services.TopicPermissions.Get(ctx, "vhost", "user", "exchange")
+			return err
+		}},
+		{"UserLimits", func() error {
+			_, err := services.UserLimits.Get(ctx, "name")
+			return err
+		}},
+		{"Users", func() error {
+			_, err := services.Users.Get(ctx, "name")
+			return err
+		}},
+		{"VhostLimits", func() error {
+			_, err := services.VhostLimits.Get(ctx, "vhost")
+			return err
+		}},
+		{"Vhosts", func() error {
+			_, err := services.Vhosts.Get(ctx, "vhost")
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.get(); !errors.Is(err, ErrNotFound) {
+				t.Errorf("error = %v, want %v", err, ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestListReturnsEmptyOnNotFound(t *testing.T) {
+	services := newNotFoundServices(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		list func() (int, error)
+	}{
+		{"Bindings", func() (int, error) {
+			result, err := services.Bindings.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"Exchanges", func() (int, error) {
+			result, err := services.Exchanges.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"FederationLinks", func() (int, error) {
+			result, err := services.FederationLinks.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"GlobalParameters", func() (int, error) {
+			result, err := services.GlobalParameters.List(ctx)
+			return len(result), err
+		}},
+		{"OperatorPolicies", func() (int, error) {
+			result, err := services.OperatorPolicies.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"Parameters", func() (int, error) {
+			result, err := services.Parameters.List(ctx, "shovel", "vhost")
+			return len(result), err
+		}},
+		{"Permissions", func() (int, error) {
+			result, err := services.Permissions.List(ctx, "vhost", "")
+			return len(result), err
+		}},
+		{"Policies", func() (int, error) {
+			result, err := services.Policies.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"Queues", func() (int, error) {
+			result, err := services.Queues.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"Shovels", func() (int, error) {
+			result, err := services.Shovels.List(ctx, "vhost")
+			return len(result), err
+		}},
+		{"TopicPermissions", func() (int, error) {
+			result, err := services.TopicPermissions.List(ctx, "vhost", "")
+			return len(result), err
+		}},
+		{"Users", func() (int, error) {
+			result, err := services.Users.List(ctx)
+			return len(result), err
+		}},
+		{"Vhosts", func() (int, error) {
+			result, err := services.Vhosts.List(ctx)
+			return len(result), err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.list()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if n != 0 {
+				t.Errorf("len = %d, want 0", n)
+			}
+		})
+	}
+}
+
+func TestDeleteIgnoresNotFound(t *testing.T) {
+	services := newNotFoundServices(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		delete func() error
+	}{
+		{"Bindings", func() error {
+			return services.Bindings.Delete(ctx, "vhost", "source", "destination", "q", "~")
+		}},
+		{"Exchanges", func() error { return services.Exchanges.Delete(ctx, "vhost", "name") }},
+		{"GlobalParameters", func() error { return services.GlobalParameters.Delete(ctx, "name") }},
+		{"OperatorPolicies", func() error { return services.OperatorPolicies.Delete(ctx, "vhost", "name") }},
+		{"Parameters", func() error { return services.Parameters.Delete(ctx, "shovel", "vhost", "name") }},
+		{"Permissions", func() error { return services.Permissions.Delete(ctx, "vhost", "user") }},
+		{"Policies", func() error { return services.Policies.Delete(ctx, "vhost", "name") }},
+		{"Queues", func() error { return services.Queues.Delete(ctx, "vhost", "name", QueueDeleteOptions{}) }},
+		{"TopicPermissions", func() error {
+			return services.TopicPermissions.Delete(ctx, "vhost", "user", "exchange")
+		}},
+		{"UserLimits", func() error { return services.UserLimits.Delete(ctx, "name", "max-connections") }},
+		{"Users", func() error { return services.Users.Delete(ctx, "name") }},
+		{"VhostLimits", func() error { return services.VhostLimits.Delete(ctx, "vhost", "max-connections") }},
+		{"Vhosts", func() error { return services.Vhosts.Delete(ctx, "vhost") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.delete(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// OperatorPoliciesService manages operator policies. They share the request
+// and response format of policies.
+type OperatorPoliciesService service
+
+func (s *OperatorPoliciesService) CreateOrUpdate(ctx context.Context, vhost, name string, policy PolicyRequest) error {
+	path := fmt.Sprintf("api/operator-policies/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodPut, path, policy)
+	return err
+}
+
+func (s *OperatorPoliciesService) Get(ctx context.Context, vhost, name string) (*PolicyResponse, error) {
+	path := fmt.Sprintf("api/operator-policies/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result *PolicyResponse
+	err = unmarshalJSON(body, &result)
+	return result, err
+}
+
+func (s *OperatorPoliciesService) List(ctx context.Context, vhost string) ([]PolicyResponse, error) {
+	path := "api/operator-policies"
+	if vhost != "" {
+		path = fmt.Sprintf("api/operator-policies/%s", url.PathEscape(vhost))
+	}
+
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []PolicyResponse{}, nil
+	}
+	if err != nil {
+		return []PolicyResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []PolicyResponse
+	err = unmarshalJSON(body, &result)
+	if err != nil {
+		return []PolicyResponse{}, err
+	}
+	return result, nil
+}
+
+func (s *OperatorPoliciesService) Delete(ctx context.Context, vhost, name string) error {
+	path := fmt.Sprintf("api/operator-policies/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
+	return ignoreNotFound(err)
+}
@@ -2,7 +2,7 @@ package clientlibrary
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -62,14 +62,11 @@ func (s *ParametersService) Get(ctx context.Context, component, vhost, name stri
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result *ParameterResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	return result, err
 }
 
@@ -85,17 +82,17 @@ func (s *ParametersService) List(ctx context.Context, component, vhost string) (
 	}
 
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []ParameterResponse{}, nil
+	}
 	if err != nil {
 		return []ParameterResponse{}, err
 	}
-	if resp == nil {
-		return []ParameterResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result []ParameterResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return []ParameterResponse{}, err
 	}
@@ -105,5 +102,5 @@ func (s *ParametersService) List(ctx context.Context, component, vhost string) (
 func (s *ParametersService) Delete(ctx context.Context, component, vhost, name string) error {
 	path := fmt.Sprintf("api/parameters/%s/%s/%s", url.PathEscape(component), url.PathEscape(vhost), url.PathEscape(name))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -0,0 +1,38 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestParametersKeepLargeIntegers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/limits") {
+			_, _ = w.Write([]byte(`{"name":"limits","vhost":"/","component":"custom","value":{"max":9007199254740993}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name":"limits","vhost":"/","component":"custom","value":{"max":9007199254740993}}]`))
+	})
+	services := NewServices(client)
+	ctx := context.Background()
+	want := `{"max":9007199254740993}`
+
+	parameter, err := services.Parameters.Get(ctx, "custom", "/", "limits")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, _ := json.Marshal(parameter.Value); string(value) != want {
+		t.Errorf("Get value = %s, want %s", value, want)
+	}
+
+	parameters, err := services.Parameters.List(ctx, "custom", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, _ := json.Marshal(parameters[0].Value); string(value) != want {
+		t.Errorf("List value = %s, want %s", value, want)
+	}
+}
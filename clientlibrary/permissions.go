@@ -3,6 +3,7 @@ package clientlibrary
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -37,9 +38,6 @@ func (s *PermissionsService) Get(ctx context.Context, vhost, user string) (*Perm
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
@@ -62,12 +60,12 @@ func (s *PermissionsService) List(ctx context.Context, vhost, user string) ([]Pe
 	}
 
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []PermissionResponse{}, nil
+	}
 	if err != nil {
 		return []PermissionResponse{}, err
 	}
-	if resp == nil {
-		return []PermissionResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
@@ -82,5 +80,5 @@ func (s *PermissionsService) List(ctx context.Context, vhost, user string) ([]Pe
 func (s *PermissionsService) Delete(ctx context.Context, vhost, user string) error {
 	path := fmt.Sprintf("api/permissions/%s/%s", url.PathEscape(vhost), url.PathEscape(user))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -2,7 +2,7 @@ package clientlibrary
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -39,14 +39,11 @@ func (s *PoliciesService) Get(ctx context.Context, vhost, name string) (*PolicyR
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result *PolicyResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	return result, err
 }
 
@@ -57,17 +54,17 @@ func (s *PoliciesService) List(ctx context.Context, vhost string) ([]PolicyRespo
 	}
 
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []PolicyResponse{}, nil
+	}
 	if err != nil {
 		return []PolicyResponse{}, err
 	}
-	if resp == nil {
-		return []PolicyResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result []PolicyResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return []PolicyResponse{}, err
 	}
@@ -77,5 +74,5 @@ func (s *PoliciesService) List(ctx context.Context, vhost string) ([]PolicyRespo
 func (s *PoliciesService) Delete(ctx context.Context, vhost, name string) error {
 	path := fmt.Sprintf("api/policies/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -0,0 +1,36 @@
+package clientlibrary
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// WithProxy returns a copy of httpClient that sends every request through the
+// proxy at proxyURL, instead of the proxy selected by the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables. An empty proxyURL disables
+// proxying. connectHeader is sent to the proxy when it tunnels HTTPS
+// connections with CONNECT.
+func WithProxy(httpClient *http.Client, proxyURL string, connectHeader http.Header) (*http.Client, error) {
+	var proxy func(*http.Request) (*url.URL, error)
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy URL: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("unsupported proxy URL scheme %q, expected http, https, socks5 or socks5h", u.Scheme)
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("proxy URL %q has no host", proxyURL)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	return ConfigureTransport(httpClient, func(transport *http.Transport) {
+		transport.Proxy = proxy
+		transport.ProxyConnectHeader = connectHeader.Clone()
+	})
+}
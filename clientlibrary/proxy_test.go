@@ -0,0 +1,130 @@
+package clientlibrary
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestWithHeaders(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Tenant"); got != "team-a" {
+			t.Errorf("X-Tenant = %q, want %q", got, "team-a")
+		}
+		if got := r.Header.Get("User-Agent"); got != "test" {
+			t.Errorf("User-Agent = %q, want the client user agent to take precedence", got)
+		}
+	}, WithHeaders(map[string]string{"x-tenant": "team-a", "User-Agent": "custom"}))
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestWithProxyForwardsRequests(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		if got := r.Header.Get("X-Tenant"); got != "team-a" {
+			t.Errorf("X-Tenant = %q, want %q", got, "team-a")
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer proxy.Close()
+
+	httpClient, err := WithProxy(http.DefaultClient, proxy.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := NewClient("http://lavinmq.invalid:15672", "test", "guest", "guest", httpClient,
+		WithHeaders(map[string]string{"X-Tenant": "team-a"}))
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if want := "http://lavinmq.invalid:15672/api/vhosts"; proxied != want {
+		t.Errorf("proxied URL = %q, want %q", proxied, want)
+	}
+}
+
+func TestWithProxyTunnelsHTTPS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(okHandler))
+	defer server.Close()
+
+	var tunnelled string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			t.Errorf("method = %s, want CONNECT", r.Method)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if got := r.Header.Get("X-Tenant"); got != "team-a" {
+			t.Errorf("X-Tenant = %q, want %q", got, "team-a")
+		}
+		tunnelled = r.Host
+
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer upstream.Close()
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		defer conn.Close()
+		_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() { defer wg.Done(); _, _ = io.Copy(upstream, conn); upstream.Close() }()
+		go func() { defer wg.Done(); _, _ = io.Copy(conn, upstream); conn.Close() }()
+		wg.Wait()
+	}))
+	defer proxy.Close()
+
+	httpClient, err := WithProxy(server.Client(), proxy.URL, http.Header{"X-Tenant": {"team-a"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := NewClient(server.URL, "test", "guest", "guest", httpClient)
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if want := server.Listener.Addr().String(); tunnelled != want {
+		t.Errorf("tunnelled host = %q, want %q", tunnelled, want)
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	t.Run("empty URL disables proxying", func(t *testing.T) {
+		httpClient, err := WithProxy(http.DefaultClient, "", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if proxy := httpClient.Transport.(*http.Transport).Proxy; proxy != nil {
+			t.Error("expected no proxy")
+		}
+	})
+
+	for _, proxyURL := range []string{"ftp://proxy.example.com", "http://", "://proxy"} {
+		t.Run(proxyURL, func(t *testing.T) {
+			if _, err := WithProxy(http.DefaultClient, proxyURL, nil); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@ package clientlibrary
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,16 +18,60 @@ type QueueRequest struct {
 }
 
 type QueueResponse struct {
-	Name       string         `json:"name"`
-	Vhost      string         `json:"vhost"`
-	AutoDelete bool           `json:"auto_delete"`
-	Durable    bool           `json:"durable"`
-	State      string         `json:"state"`
-	Consumers  int64          `json:"consumers"`
-	Messages   int64          `json:"messages"`
-	Ready      int64          `json:"ready"`
-	Unacked    int64          `json:"unacked"`
-	Arguments  map[string]any `json:"arguments,omitempty"`
+	Name                      string            `json:"name"`
+	Vhost                     string            `json:"vhost"`
+	AutoDelete                bool              `json:"auto_delete"`
+	Durable                   bool              `json:"durable"`
+	State                     string            `json:"state"`
+	Consumers                 int64             `json:"consumers"`
+	Messages                  int64             `json:"messages"`
+	Ready                     int64             `json:"ready"`
+	Unacked                   int64             `json:"unacked"`
+	TotalBytes                int64             `json:"total_bytes"`
+	ReadyBytes                int64             `json:"ready_bytes"`
+	UnackedBytes              int64             `json:"unacked_bytes"`
+	Arguments                 map[string]any    `json:"arguments,omitempty"`
+	Policy                    string            `json:"policy"`
+	OperatorPolicy            string            `json:"operator_policy"`
+	EffectivePolicyDefinition map[string]any    `json:"effective_policy_definition"`
+	EffectiveArguments        []string          `json:"effective_arguments"`
+	MessageStats              QueueMessageStats `json:"message_stats"`
+	ConsumerDetails           []QueueConsumer   `json:"consumer_details"`
+}
+
+// QueueMessageStats are the message counters of a queue since it was
+// declared, with their current rate per second.
+type QueueMessageStats struct {
+	Publish           int64       `json:"publish"`
+	PublishDetails    MessageRate `json:"publish_details"`
+	DeliverGet        int64       `json:"deliver_get"`
+	DeliverGetDetails MessageRate `json:"deliver_get_details"`
+	Ack               int64       `json:"ack"`
+	AckDetails        MessageRate `json:"ack_details"`
+	Redeliver         int64       `json:"redeliver"`
+	RedeliverDetails  MessageRate `json:"redeliver_details"`
+	Reject            int64       `json:"reject"`
+	RejectDetails     MessageRate `json:"reject_details"`
+}
+
+type MessageRate struct {
+	Rate float64 `json:"rate"`
+}
+
+// QueueConsumer is a consumer subscribed to a queue.
+type QueueConsumer struct {
+	ConsumerTag    string               `json:"consumer_tag"`
+	Exclusive      bool                 `json:"exclusive"`
+	AckRequired    bool                 `json:"ack_required"`
+	PrefetchCount  int64                `json:"prefetch_count"`
+	ChannelDetails QueueConsumerChannel `json:"channel_details"`
+}
+
+type QueueConsumerChannel struct {
+	Name           string `json:"name"`
+	ConnectionName string `json:"connection_name"`
+	PeerHost       string `json:"peer_host"`
+	User           string `json:"user"`
 }
 
 func (s *QueuesService) CreateOrUpdate(ctx context.Context, vhost, name string, req QueueRequest) error {
@@ -42,14 +86,11 @@ func (s *QueuesService) Get(ctx context.Context, vhost, name string) (*QueueResp
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result QueueResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -62,27 +103,112 @@ func (s *QueuesService) List(ctx context.Context, vhost string) ([]QueueResponse
 		path = fmt.Sprintf("api/queues/%s", url.PathEscape(vhost))
 	}
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []QueueResponse{}, nil
+	}
 	if err != nil {
 		return []QueueResponse{}, err
 	}
-	if resp == nil {
-		return []QueueResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 	var result []QueueResponse
-	err = json.Unmarshal(body, &result)
+	err = unmarshalJSON(body, &result)
 	if err != nil {
 		return []QueueResponse{}, err
 	}
 	return result, nil
 }
 
-func (s *QueuesService) Delete(ctx context.Context, vhost, name string) error {
+// QueueDeleteOptions are the conditions the broker checks before deleting a
+// queue.
+type QueueDeleteOptions struct {
+	// IfEmpty only deletes the queue when it has no messages.
+	IfEmpty bool
+	// IfUnused only deletes the queue when it has no consumers.
+	IfUnused bool
+}
+
+// QueueNotEmptyError is returned by Delete when the queue is deleted with
+// if-empty, or non-empty queue protection is enabled, and the broker refused
+// to delete the queue because it contains messages.
+type QueueNotEmptyError struct {
+	Vhost    string
+	Name     string
+	Messages int64
+}
+
+func (e *QueueNotEmptyError) Error() string {
+	return fmt.Sprintf("refusing to delete queue %q in vhost %q, it contains %d messages", e.Name, e.Vhost, e.Messages)
+}
+
+// QueueInUseError is returned by Delete when the queue is deleted with
+// if-unused and the broker refused to delete the queue because it has
+// consumers.
+type QueueInUseError struct {
+	Vhost     string
+	Name      string
+	Consumers int64
+}
+
+func (e *QueueInUseError) Error() string {
+	if e.Consumers == 0 {
+		return fmt.Sprintf("refusing to delete queue %q in vhost %q, it is in use", e.Name, e.Vhost)
+	}
+	return fmt.Sprintf("refusing to delete queue %q in vhost %q, it is in use by %d consumers", e.Name, e.Vhost, e.Consumers)
+}
+
+// Delete deletes the queue. With non-empty queue protection enabled the queue
+// is always deleted with if-empty, so the broker refuses to delete a queue
+// that received messages after it was last read.
+func (s *QueuesService) Delete(ctx context.Context, vhost, name string, opts QueueDeleteOptions) error {
+	if s.client.protectNonEmptyQueues {
+		opts.IfEmpty = true
+	}
+
 	path := fmt.Sprintf("api/queues/%s/%s", url.PathEscape(vhost), url.PathEscape(name))
-	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	req, err := s.client.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	if opts.IfEmpty {
+		query.Set("if-empty", "true")
+	}
+	if opts.IfUnused {
+		query.Set("if-unused", "true")
+	}
+	req.URL.RawQuery = query.Encode()
+
+	_, err = s.client.Do(ctx, req)
+	if (opts.IfEmpty || opts.IfUnused) && queueDeleteRefused(err) {
+		// The broker refuses both if-empty and if-unused deletions with the
+		// same status, the queue is read to tell them apart.
+		if queue, getErr := s.Get(ctx, vhost, name); getErr == nil {
+			switch {
+			case opts.IfEmpty && queue.Messages > 0:
+				return &QueueNotEmptyError{Vhost: vhost, Name: name, Messages: queue.Messages}
+			case opts.IfUnused:
+				return &QueueInUseError{Vhost: vhost, Name: name, Consumers: queue.Consumers}
+			}
+		}
+	}
+	return ignoreNotFound(err)
+}
+
+// queueDeleteRefused reports whether the broker refused a conditional queue
+// deletion, which it answers with a client error other than an
+// authentication, authorization or not found error.
+func queueDeleteRefused(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return false
+	}
+	return apiErr.StatusCode >= http.StatusBadRequest && apiErr.StatusCode < http.StatusInternalServerError
 }
 
 func (s *QueuesService) Pause(ctx context.Context, vhost, name string, pause bool) error {
@@ -0,0 +1,131 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestQueuesDeleteOptions(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	services := NewServices(client)
+
+	ctx := context.Background()
+	if err := services.Queues.Delete(ctx, "vhost", "plain", QueueDeleteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := services.Queues.Delete(ctx, "vhost", "guarded", QueueDeleteOptions{IfEmpty: true, IfUnused: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"DELETE /api/queues/vhost/plain",
+		"DELETE /api/queues/vhost/guarded?if-empty=true&if-unused=true",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestQueuesDeleteNonEmptyQueueProtection(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"bad_request","reason":"Queue queue in vhost vhost not empty"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"queue","vhost":"vhost","messages":42}`))
+	}, WithNonEmptyQueueProtection())
+	services := NewServices(client)
+
+	err := services.Queues.Delete(context.Background(), "vhost", "queue", QueueDeleteOptions{IfUnused: true})
+	var notEmpty *QueueNotEmptyError
+	if !errors.As(err, &notEmpty) || notEmpty.Messages != 42 {
+		t.Fatalf("error = %v, want QueueNotEmptyError with 42 messages", err)
+	}
+
+	want := []string{
+		"DELETE /api/queues/vhost/queue?if-empty=true&if-unused=true",
+		"GET /api/queues/vhost/queue",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestQueuesDeleteNonEmptyQueueProtectionInUse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"bad_request","reason":"Queue queue in vhost vhost in use"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"queue","vhost":"vhost","messages":0,"consumers":2}`))
+	}, WithNonEmptyQueueProtection())
+	services := NewServices(client)
+
+	err := services.Queues.Delete(context.Background(), "vhost", "queue", QueueDeleteOptions{IfUnused: true})
+	var inUse *QueueInUseError
+	if !errors.As(err, &inUse) || inUse.Consumers != 2 {
+		t.Fatalf("error = %v, want QueueInUseError with 2 consumers", err)
+	}
+}
+
+func TestQueuesDeleteRefusedStatus(t *testing.T) {
+	tests := []struct {
+		status  int
+		refused bool
+	}{
+		{http.StatusBadRequest, true},
+		{http.StatusNotAcceptable, true},
+		{http.StatusConflict, true},
+		{http.StatusPreconditionFailed, true},
+		{http.StatusForbidden, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"name":"queue","vhost":"vhost","messages":42}`))
+			})
+			services := NewServices(client)
+
+			err := services.Queues.Delete(context.Background(), "vhost", "queue", QueueDeleteOptions{IfEmpty: true})
+			var notEmpty *QueueNotEmptyError
+			if errors.As(err, &notEmpty) != tt.refused {
+				t.Errorf("error = %v, want QueueNotEmptyError %t", err, tt.refused)
+			}
+		})
+	}
+}
+
+func TestQueuesDeleteEmptyQueueProtection(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.WriteHeader(http.StatusNoContent)
+	}, WithNonEmptyQueueProtection())
+	services := NewServices(client)
+
+	if err := services.Queues.Delete(context.Background(), "vhost", "queue", QueueDeleteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"DELETE /api/queues/vhost/queue?if-empty=true"}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,40 @@
+package clientlibrary
+
+import (
+	"net/http"
+	"regexp"
+)
+
+// Redacted replaces the secrets removed by RedactSecrets and RedactHeaders.
+const Redacted = "REDACTED"
+
+var (
+	// passwordPattern matches the values of password and password_hash keys in
+	// JSON documents.
+	passwordPattern = regexp.MustCompile(`("(?:password|password_hash)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// amqpURIPattern matches the password of AMQP URIs, e.g. in shovel and
+	// federation upstream values. The password ends at the last '@' of the
+	// URI, so unencoded '@' and '/' in the password are redacted as well.
+	amqpURIPattern = regexp.MustCompile(`(amqps?://[^:/@\s"]*:)[^\s"]*@`)
+)
+
+// sensitiveHeaders are replaced by RedactHeaders.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// RedactSecrets replaces passwords, password hashes and the passwords of AMQP
+// URIs in a request or response body.
+func RedactSecrets(body string) string {
+	body = passwordPattern.ReplaceAllString(body, `$1"`+Redacted+`"`)
+	return amqpURIPattern.ReplaceAllString(body, "${1}"+Redacted+"@")
+}
+
+// RedactHeaders returns a copy of the headers with credentials replaced.
+func RedactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := redacted[name]; ok {
+			redacted[name] = []string{Redacted}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,143 @@
+package clientlibrary
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client.Do retries failed requests.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the
+	// first one. A value of 1 or less disables retries.
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry, doubled for every
+	// following attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between two attempts.
+	MaxBackoff time.Duration
+	// MaxElapsedTime caps the total time spent on a request including retries.
+	// Zero means no cap other than the request context.
+	MaxElapsedTime time.Duration
+	// StatusCodes lists the HTTP status codes that are retried.
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns the policy used when no other policy is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		MinBackoff:     500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		MaxElapsedTime: 2 * time.Minute,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retryableStatus reports whether a response with the given status code should
+// be retried. Non-idempotent requests are only retried when the broker
+// explicitly signals that the request was not processed.
+func (p RetryPolicy) retryableStatus(method string, statusCode int) bool {
+	if !slices.Contains(p.StatusCodes, statusCode) {
+		return false
+	}
+	if isIdempotent(method) {
+		return true
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// retryableError reports whether a transport error should be retried. Errors
+// for non-idempotent requests are only retried when the connection was never
+// established, e.g. connection refused while the broker restarts. TLS errors
+// caused by the configuration, e.g. an unknown CA, are never retried.
+func (p RetryPolicy) retryableError(method string, err error) bool {
+	if isPermanentTLSError(err) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return isIdempotent(method)
+}
+
+// isPermanentTLSError reports whether err is a TLS error that fails the same
+// way on every attempt.
+func isPermanentTLSError(err error) bool {
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certificateErr *tls.CertificateVerificationError
+	var recordHeaderErr tls.RecordHeaderError
+	return errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &certificateErr) ||
+		errors.As(err, &recordHeaderErr)
+}
+
+// backoff returns the wait before the given retry (1 for the first retry).
+// A Retry-After header on the response takes precedence over the
+// exponential backoff.
+func (p RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < retry && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Equal jitter: keep half of the backoff and randomize the other half.
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// retryAfter parses a Retry-After header value given either as seconds or as
+// an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,29 +1,41 @@
 package clientlibrary
 
 type Services struct {
-	Users       *UsersService
-	VhostLimits *VhostLimitsService
-	Vhosts      *VhostsService
-	Queues      *QueuesService
-	Policies    *PoliciesService
-	Exchanges   *ExchangesService
-	Permissions *PermissionsService
-	Parameters  *ParametersService
-	Bindings    *BindingsService
-	Messages    *MessagesService
+	Users            *UsersService
+	UserLimits       *UserLimitsService
+	VhostLimits      *VhostLimitsService
+	Vhosts           *VhostsService
+	Queues           *QueuesService
+	Policies         *PoliciesService
+	OperatorPolicies *OperatorPoliciesService
+	Exchanges        *ExchangesService
+	Permissions      *PermissionsService
+	TopicPermissions *TopicPermissionsService
+	Parameters       *ParametersService
+	FederationLinks  *FederationLinksService
+	Shovels          *ShovelsService
+	GlobalParameters *GlobalParametersService
+	Bindings         *BindingsService
+	Messages         *MessagesService
 }
 
 func NewServices(client *Client) *Services {
 	return &Services{
-		Users:       (*UsersService)(&service{client: client}),
-		VhostLimits: (*VhostLimitsService)(&service{client: client}),
-		Vhosts:      (*VhostsService)(&service{client: client}),
-		Queues:      (*QueuesService)(&service{client: client}),
-		Policies:    (*PoliciesService)(&service{client: client}),
-		Exchanges:   (*ExchangesService)(&service{client: client}),
-		Permissions: (*PermissionsService)(&service{client: client}),
-		Parameters:  (*ParametersService)(&service{client: client}),
-		Bindings:    (*BindingsService)(&service{client: client}),
-		Messages:    (*MessagesService)(&service{client: client}),
+		Users:            (*UsersService)(&service{client: client}),
+		UserLimits:       (*UserLimitsService)(&service{client: client}),
+		VhostLimits:      (*VhostLimitsService)(&service{client: client}),
+		Vhosts:           (*VhostsService)(&service{client: client}),
+		Queues:           (*QueuesService)(&service{client: client}),
+		Policies:         (*PoliciesService)(&service{client: client}),
+		OperatorPolicies: (*OperatorPoliciesService)(&service{client: client}),
+		Exchanges:        (*ExchangesService)(&service{client: client}),
+		Permissions:      (*PermissionsService)(&service{client: client}),
+		TopicPermissions: &TopicPermissionsService{client: client},
+		Parameters:       (*ParametersService)(&service{client: client}),
+		FederationLinks:  (*FederationLinksService)(&service{client: client}),
+		Shovels:          (*ShovelsService)(&service{client: client}),
+		GlobalParameters: (*GlobalParametersService)(&service{client: client}),
+		Bindings:         (*BindingsService)(&service{client: client}),
+		Messages:         (*MessagesService)(&service{client: client}),
 	}
 }
@@ -0,0 +1,83 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type ShovelsService service
+
+// ShovelResponse is the runtime status of a shovel. The shovel definition
+// itself is managed through ParametersService with the shovel component.
+type ShovelResponse struct {
+	Name  string `json:"name"`
+	Vhost string `json:"vhost"`
+	State string `json:"state"`
+	Error string `json:"error"`
+}
+
+// Get returns the status of a single shovel, or ErrNotFound when the shovel
+// is not running on the broker.
+func (s *ShovelsService) Get(ctx context.Context, vhost, name string) (*ShovelResponse, error) {
+	shovels, err := s.List(ctx, vhost)
+	if err != nil {
+		return nil, err
+	}
+	for _, shovel := range shovels {
+		if shovel.Name == name {
+			return &shovel, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *ShovelsService) List(ctx context.Context, vhost string) ([]ShovelResponse, error) {
+	path := "api/shovels"
+	if vhost != "" {
+		path = fmt.Sprintf("api/shovels/%s", url.PathEscape(vhost))
+	}
+
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []ShovelResponse{}, nil
+	}
+	if err != nil {
+		return []ShovelResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []ShovelResponse
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return []ShovelResponse{}, err
+	}
+	return result, nil
+}
+
+func (s *ShovelsService) Pause(ctx context.Context, vhost, name string) error {
+	path := fmt.Sprintf("api/shovels/%s/%s/pause", url.PathEscape(vhost), url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodPut, path, nil)
+	return err
+}
+
+func (s *ShovelsService) Resume(ctx context.Context, vhost, name string) error {
+	path := fmt.Sprintf("api/shovels/%s/%s/resume", url.PathEscape(vhost), url.PathEscape(name))
+	_, err := s.client.Request(ctx, http.MethodPut, path, nil)
+	return err
+}
+
+// Restart pauses and resumes the shovel, which closes its connections and
+// connects to the source and destination again. A paused shovel is running
+// after a restart.
+func (s *ShovelsService) Restart(ctx context.Context, vhost, name string) error {
+	if err := s.Pause(ctx, vhost, name); err != nil {
+		return err
+	}
+	return s.Resume(ctx, vhost, name)
+}
@@ -0,0 +1,44 @@
+package clientlibrary
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestShovelsRestart(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	services := NewServices(client)
+
+	if err := services.Shovels.Restart(context.Background(), "/", "shovel"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"PUT /api/shovels/%2F/shovel/pause",
+		"PUT /api/shovels/%2F/shovel/resume",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestShovelsGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"other","vhost":"/","state":"Running"},{"name":"shovel","vhost":"/","state":"Error","error":"connection refused"}]`))
+	})
+	services := NewServices(client)
+
+	shovel, err := services.Shovels.Get(context.Background(), "/", "shovel")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shovel.State != "Error" || shovel.Error != "connection refused" {
+		t.Errorf("shovel = %+v", shovel)
+	}
+}
@@ -0,0 +1,116 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}, WithMaxConcurrentRequests(3))
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 3 {
+		t.Errorf("peak in-flight requests = %d, want at most 3", got)
+	}
+}
+
+func TestMaxConcurrentRequestsHonoursContext(t *testing.T) {
+	release := make(chan struct{})
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}, WithMaxConcurrentRequests(1))
+	defer close(release)
+
+	go func() {
+		resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	// Wait until the first request holds the only slot.
+	for len(client.inFlight) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Request(ctx, http.MethodGet, "api/vhosts", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}, WithRateLimit(50, 5))
+
+	start := time.Now()
+	for range 30 {
+		resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The burst of 5 is sent at once, the remaining 25 requests are spread
+	// out at 50 per second.
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Errorf("elapsed = %s, want at least 450ms", elapsed)
+	}
+	if got := requests.Load(); got != 30 {
+		t.Errorf("requests = %d, want 30", got)
+	}
+}
+
+func TestRateLimitHonoursContext(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {}, WithRateLimit(0.1, 1))
+
+	resp, err := client.Request(context.Background(), http.MethodGet, "api/vhosts", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.Request(ctx, http.MethodGet, "api/vhosts", nil); err == nil {
+		t.Fatal("expected error while waiting for the rate limiter")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("elapsed = %s, want the request to give up early", elapsed)
+	}
+}
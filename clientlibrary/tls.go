@@ -0,0 +1,93 @@
+package clientlibrary
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSConfig holds the TLS settings used when connecting to the management API.
+type TLSConfig struct {
+	// CACertPEM is a PEM bundle of certificate authorities trusted in addition
+	// to the system pool.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded certificate and key
+	// presented to the server. Both or neither must be set.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// ServerName overrides the hostname used to verify the server certificate.
+	ServerName string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// Build returns the crypto/tls configuration for the settings.
+func (c TLSConfig) Build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if len(c.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(c.CACertPEM) {
+			return nil, errors.New("no valid PEM certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(c.ClientCertPEM) > 0 || len(c.ClientKeyPEM) > 0 {
+		if len(c.ClientCertPEM) == 0 || len(c.ClientKeyPEM) == 0 {
+			return nil, errors.New("both client certificate and client key must be set")
+		}
+		cert, err := tls.X509KeyPair(c.ClientCertPEM, c.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// ConfigureTransport returns a copy of httpClient with a cloned transport
+// modified by configure. The original client and its transport are left
+// untouched. A nil transport is treated as http.DefaultTransport.
+func ConfigureTransport(httpClient *http.Client, configure func(*http.Transport)) (*http.Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("cannot configure transport of type %T", base)
+	}
+
+	transport = transport.Clone()
+	configure(transport)
+
+	clone := *httpClient
+	clone.Transport = transport
+	return &clone, nil
+}
+
+// WithTLSConfig returns a copy of httpClient that uses the TLS settings.
+func WithTLSConfig(httpClient *http.Client, config TLSConfig) (*http.Client, error) {
+	tlsConfig, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+	return ConfigureTransport(httpClient, func(transport *http.Transport) {
+		transport.TLSClientConfig = tlsConfig
+	})
+}
@@ -0,0 +1,176 @@
+package clientlibrary
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func serverCACertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// newTestClientCert returns a self-signed client certificate and key in PEM
+// format together with a pool that trusts it.
+func newTestClientCert(t *testing.T) ([]byte, []byte, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		pool
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(okHandler))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		config  TLSConfig
+		wantErr string
+	}{
+		{"untrusted server certificate", TLSConfig{}, "certificate"},
+		{"trusted CA", TLSConfig{CACertPEM: serverCACertPEM(server)}, ""},
+		{"server name matching certificate", TLSConfig{CACertPEM: serverCACertPEM(server), ServerName: "example.com"}, ""},
+		{"server name not matching certificate", TLSConfig{CACertPEM: serverCACertPEM(server), ServerName: "lavinmq.test"}, "lavinmq.test"},
+		{"insecure skip verify", TLSConfig{InsecureSkipVerify: true}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient, err := WithTLSConfig(&http.Client{}, tt.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			policy := testRetryPolicy()
+			policy.MaxAttempts = 1
+			client := NewClient(server.URL, "test", "guest", "guest", httpClient, WithRetryPolicy(policy))
+
+			_, err = client.Request(context.Background(), http.MethodGet, "api/overview", nil)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("expected error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithTLSConfigClientCertificate(t *testing.T) {
+	certPEM, keyPEM, clientCAs := newTestClientCert(t)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(okHandler))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 1
+
+	withoutCert, err := WithTLSConfig(&http.Client{}, TLSConfig{CACertPEM: serverCACertPEM(server)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := NewClient(server.URL, "test", "guest", "guest", withoutCert, WithRetryPolicy(policy))
+	if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err == nil {
+		t.Error("expected error without client certificate")
+	}
+
+	withCert, err := WithTLSConfig(&http.Client{}, TLSConfig{
+		CACertPEM:     serverCACertPEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client = NewClient(server.URL, "test", "guest", "guest", withCert, WithRetryPolicy(policy))
+	if _, err := client.Request(context.Background(), http.MethodGet, "api/overview", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSConfigBuildErrors(t *testing.T) {
+	certPEM, keyPEM, _ := newTestClientCert(t)
+	tests := []struct {
+		name   string
+		config TLSConfig
+	}{
+		{"invalid CA certificate", TLSConfig{CACertPEM: []byte("not a certificate")}},
+		{"client certificate without key", TLSConfig{ClientCertPEM: certPEM}},
+		{"client key without certificate", TLSConfig{ClientKeyPEM: keyPEM}},
+		{"mismatched client certificate and key", TLSConfig{ClientCertPEM: certPEM, ClientKeyPEM: []byte("not a key")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.config.Build(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestConfigureTransportLeavesOriginalUntouched(t *testing.T) {
+	original := &http.Client{Timeout: time.Minute}
+	httpClient, err := WithTLSConfig(original, TLSConfig{ServerName: "lavinmq.test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if original.Transport != nil {
+		t.Error("original client transport was modified")
+	}
+	if httpClient.Timeout != original.Timeout {
+		t.Errorf("timeout = %s, want %s", httpClient.Timeout, original.Timeout)
+	}
+
+	custom := &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, nil })}
+	if _, err := WithTLSConfig(custom, TLSConfig{}); err == nil {
+		t.Error("expected error for a transport that is not an *http.Transport")
+	}
+}
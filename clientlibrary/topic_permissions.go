@@ -0,0 +1,166 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// TopicPermissionsService manages the topic permissions of users. Deleting a
+// single topic permission rewrites all topic permissions of the user in the
+// vhost, so writes for the same vhost and user are serialized.
+type TopicPermissionsService struct {
+	client *Client
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock serializes writes to the topic permissions of a user in a vhost and
+// returns the function releasing the lock.
+func (s *TopicPermissionsService) lock(vhost, user string) func() {
+	key := vhost + "\x00" + user
+	s.mu.Lock()
+	if s.locks == nil {
+		s.locks = make(map[string]*sync.Mutex)
+	}
+	l, ok := s.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[key] = l
+	}
+	s.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+type TopicPermissionRequest struct {
+	Exchange string `json:"exchange"`
+	Write    string `json:"write"`
+	Read     string `json:"read"`
+}
+
+type TopicPermissionResponse struct {
+	User     string `json:"user"`
+	Vhost    string `json:"vhost"`
+	Exchange string `json:"exchange"`
+	Write    string `json:"write"`
+	Read     string `json:"read"`
+}
+
+func (s *TopicPermissionsService) CreateOrUpdate(ctx context.Context, vhost, user string, permission TopicPermissionRequest) error {
+	defer s.lock(vhost, user)()
+	return s.put(ctx, vhost, user, permission)
+}
+
+func (s *TopicPermissionsService) put(ctx context.Context, vhost, user string, permission TopicPermissionRequest) error {
+	path := fmt.Sprintf("api/topic-permissions/%s/%s", url.PathEscape(vhost), url.PathEscape(user))
+	_, err := s.client.Request(ctx, http.MethodPut, path, permission)
+	return err
+}
+
+// Get returns the topic permission of the user for a single exchange, or
+// ErrNotFound when the user has no topic permission for the exchange.
+func (s *TopicPermissionsService) Get(ctx context.Context, vhost, user, exchange string) (*TopicPermissionResponse, error) {
+	permissions, err := s.get(ctx, vhost, user)
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissions {
+		if permission.Exchange == exchange {
+			return &permission, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *TopicPermissionsService) List(ctx context.Context, vhost, user string) ([]TopicPermissionResponse, error) {
+	var path string
+	switch {
+	case vhost != "" && user != "":
+		path = fmt.Sprintf("api/topic-permissions/%s/%s", url.PathEscape(vhost), url.PathEscape(user))
+	case vhost != "":
+		path = fmt.Sprintf("api/vhosts/%s/topic-permissions", url.PathEscape(vhost))
+	case user != "":
+		path = fmt.Sprintf("api/users/%s/topic-permissions", url.PathEscape(user))
+	default:
+		path = "api/topic-permissions"
+	}
+
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return []TopicPermissionResponse{}, nil
+	}
+	if err != nil {
+		return []TopicPermissionResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []TopicPermissionResponse
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return []TopicPermissionResponse{}, err
+	}
+	return result, nil
+}
+
+// Delete removes the topic permission of the user for a single exchange. The
+// API can only clear all topic permissions of a user in a vhost, so the
+// permissions for the other exchanges are written back afterwards. This is not
+// atomic: every permission that could not be written back is reported in the
+// returned error.
+func (s *TopicPermissionsService) Delete(ctx context.Context, vhost, user, exchange string) error {
+	defer s.lock(vhost, user)()
+
+	permissions, err := s.get(ctx, vhost, user)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("api/topic-permissions/%s/%s", url.PathEscape(vhost), url.PathEscape(user))
+	if _, err := s.client.Request(ctx, http.MethodDelete, path, nil); ignoreNotFound(err) != nil {
+		return err
+	}
+
+	var errs []error
+	for _, permission := range permissions {
+		if permission.Exchange == exchange {
+			continue
+		}
+		err := s.put(ctx, vhost, user, TopicPermissionRequest{
+			Exchange: permission.Exchange,
+			Write:    permission.Write,
+			Read:     permission.Read,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore topic permission for exchange %q (write %q, read %q): %w",
+				permission.Exchange, permission.Write, permission.Read, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// get returns all topic permissions of the user in the vhost.
+func (s *TopicPermissionsService) get(ctx context.Context, vhost, user string) ([]TopicPermissionResponse, error) {
+	path := fmt.Sprintf("api/topic-permissions/%s/%s", url.PathEscape(vhost), url.PathEscape(user))
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result []TopicPermissionResponse
+	err = json.Unmarshal(body, &result)
+	return result, err
+}
@@ -0,0 +1,166 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTopicPermissionsDeleteKeepsOtherExchanges(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.EscapedPath()+" "+string(body)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[
+				{"user":"user","vhost":"/","exchange":"amq.topic","write":"^a","read":".*"},
+				{"user":"user","vhost":"/","exchange":"events","write":"^b","read":"^c"}
+			]`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	services := NewServices(client)
+
+	if err := services.TopicPermissions.Delete(context.Background(), "/", "user", "amq.topic"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"GET /api/topic-permissions/%2F/user",
+		"DELETE /api/topic-permissions/%2F/user",
+		`PUT /api/topic-permissions/%2F/user {"exchange":"events","write":"^b","read":"^c"}`,
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestTopicPermissionsDeleteReportsFailedRestores(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`[
+				{"user":"user","vhost":"/","exchange":"amq.topic","write":"^a","read":".*"},
+				{"user":"user","vhost":"/","exchange":"events","write":"^b","read":"^c"},
+				{"user":"user","vhost":"/","exchange":"logs","write":"^d","read":"^e"},
+				{"user":"user","vhost":"/","exchange":"metrics","write":"^f","read":"^g"}
+			]`))
+		case http.MethodPut:
+			requests = append(requests, strings.TrimSpace(string(body)))
+			if strings.Contains(string(body), "metrics") {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"bad_request","reason":"invalid"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	services := NewServices(client)
+
+	err := services.TopicPermissions.Delete(context.Background(), "/", "user", "amq.topic")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("error = %v, want %v", err, ErrBadRequest)
+	}
+	for _, want := range []string{`exchange "events" (write "^b", read "^c")`, `exchange "logs" (write "^d", read "^e")`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "metrics") {
+		t.Errorf("error %q reports the restored exchange metrics", err)
+	}
+	if len(requests) != 3 {
+		t.Errorf("restored %d permissions, want every permission tried: %q", len(requests), requests)
+	}
+}
+
+func TestTopicPermissionsGetMissingExchange(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"user":"user","vhost":"/","exchange":"events","write":".*","read":".*"}]`))
+	})
+	services := NewServices(client)
+
+	_, err := services.TopicPermissions.Get(context.Background(), "/", "user", "amq.topic")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestTopicPermissionsConcurrentWrites(t *testing.T) {
+	var mu sync.Mutex
+	permissions := map[string]TopicPermissionRequest{
+		"amq.topic": {Exchange: "amq.topic", Write: ".*", Read: ".*"},
+		"events":    {Exchange: "events", Write: ".*", Read: ".*"},
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			result := make([]TopicPermissionResponse, 0, len(permissions))
+			for _, p := range permissions {
+				result = append(result, TopicPermissionResponse{User: "user", Vhost: "/", Exchange: p.Exchange, Write: p.Write, Read: p.Read})
+			}
+			mu.Unlock()
+			// Give a concurrent write the chance to run between the read and
+			// the rewrite of the permissions.
+			time.Sleep(20 * time.Millisecond)
+			_ = json.NewEncoder(w).Encode(result)
+		case http.MethodDelete:
+			mu.Lock()
+			clear(permissions)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var p TopicPermissionRequest
+			_ = json.NewDecoder(r.Body).Decode(&p)
+			mu.Lock()
+			permissions[p.Exchange] = p
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	services := NewServices(client)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errs <- services.TopicPermissions.Delete(ctx, "/", "user", "amq.topic")
+	}()
+	go func() {
+		defer wg.Done()
+		time.Sleep(5 * time.Millisecond)
+		errs <- services.TopicPermissions.CreateOrUpdate(ctx, "/", "user", TopicPermissionRequest{Exchange: "logs", Write: ".*", Read: ".*"})
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var exchanges []string
+	for exchange := range permissions {
+		exchanges = append(exchanges, exchange)
+	}
+	slices.Sort(exchanges)
+	want := []string{"events", "logs"}
+	if !slices.Equal(exchanges, want) {
+		t.Errorf("exchanges = %q, want %q", exchanges, want)
+	}
+}
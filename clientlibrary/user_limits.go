@@ -0,0 +1,82 @@
+package clientlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type UserLimitsService service
+
+type UserLimitsResponse struct {
+	User  string     `json:"user"`
+	Value UserLimits `json:"value"`
+}
+
+type UserLimits struct {
+	MaxConnections *int64 `json:"max-connections,omitempty"`
+	MaxChannels    *int64 `json:"max-channels,omitempty"`
+}
+
+// Update sets max-connections and max-channels of the user, and removes the
+// ones that are nil.
+func (s *UserLimitsService) Update(ctx context.Context, user string, limits UserLimits) error {
+	keys := []struct {
+		name  string
+		value *int64
+	}{
+		{"max-connections", limits.MaxConnections},
+		{"max-channels", limits.MaxChannels},
+	}
+	for _, k := range keys {
+		var err error
+		if k.value != nil {
+			err = s.Set(ctx, user, k.name, *k.value)
+		} else {
+			err = s.Delete(ctx, user, k.name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set sets a single limit of the user, e.g. max-connections.
+func (s *UserLimitsService) Set(ctx context.Context, user, limitType string, value int64) error {
+	path := fmt.Sprintf("api/user-limits/%s/%s", url.PathEscape(user), url.PathEscape(limitType))
+	_, err := s.client.Request(ctx, http.MethodPut, path, limitValueRequest{Value: value})
+	return err
+}
+
+// Get returns the limits of the user. A user without limits returns empty
+// limits, a user that does not exist returns ErrNotFound.
+func (s *UserLimitsService) Get(ctx context.Context, user string) (UserLimitsResponse, error) {
+	path := fmt.Sprintf("api/user-limits/%s", url.PathEscape(user))
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return UserLimitsResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var userLimitsResponses []UserLimitsResponse
+	if err := json.Unmarshal(body, &userLimitsResponses); err != nil {
+		return UserLimitsResponse{}, err
+	}
+	for _, v := range userLimitsResponses {
+		if v.User == user {
+			return v, nil
+		}
+	}
+	return UserLimitsResponse{User: user}, nil
+}
+
+func (s *UserLimitsService) Delete(ctx context.Context, user, limitType string) error {
+	path := fmt.Sprintf("api/user-limits/%s/%s", url.PathEscape(user), url.PathEscape(limitType))
+	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
+	return ignoreNotFound(err)
+}
@@ -3,6 +3,7 @@ package clientlibrary
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -37,9 +38,6 @@ func (s *UsersService) Get(ctx context.Context, username string) (*UserResponse,
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil {
-		return nil, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
@@ -50,12 +48,12 @@ func (s *UsersService) Get(ctx context.Context, username string) (*UserResponse,
 
 func (s *UsersService) List(ctx context.Context) ([]UserResponse, error) {
 	resp, err := s.client.Request(ctx, http.MethodGet, "api/users", nil)
+	if errors.Is(err, ErrNotFound) {
+		return []UserResponse{}, nil
+	}
 	if err != nil {
 		return []UserResponse{}, err
 	}
-	if resp == nil {
-		return []UserResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
@@ -70,5 +68,5 @@ func (s *UsersService) List(ctx context.Context) ([]UserResponse, error) {
 func (s *UsersService) Delete(ctx context.Context, username string) error {
 	path := fmt.Sprintf("api/users/%s", url.PathEscape(username))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -5,15 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type VhostLimitsService service
 
-type vhostLimitValueRequest struct {
+type limitValueRequest struct {
 	Value int64 `json:"value"`
 }
 
@@ -27,32 +29,60 @@ type VhostLimits struct {
 	MaxQueues      *int64 `json:"max-queues,omitempty"`
 }
 
+// Update sets max-connections and max-queues, and removes the ones that are
+// nil.
 func (s *VhostLimitsService) Update(ctx context.Context, vhost string, limits VhostLimits) error {
-	if limits.MaxConnections != nil {
-		path := fmt.Sprintf("/api/vhost-limits/%s/%s", url.PathEscape(vhost), "max-connections")
-		value := vhostLimitValueRequest{Value: *limits.MaxConnections}
-		tflog.Debug(ctx, fmt.Sprintf("Vhost limits update path: %s, value: %v\n", path, value))
-		if _, err := s.client.Request(ctx, http.MethodPut, path, value); err != nil {
+	keys := []struct {
+		name  string
+		value *int64
+	}{
+		{"max-connections", limits.MaxConnections},
+		{"max-queues", limits.MaxQueues},
+	}
+	for _, k := range keys {
+		var err error
+		if k.value != nil {
+			err = s.Set(ctx, vhost, k.name, *k.value)
+		} else {
+			err = s.Delete(ctx, vhost, k.name)
+		}
+		if err != nil {
 			return err
 		}
-	} else {
-		_ = s.Delete(ctx, vhost, "max-connections")
 	}
+	return nil
+}
 
-	if limits.MaxQueues != nil {
-		path := fmt.Sprintf("/api/vhost-limits/%s/%s", url.PathEscape(vhost), "max-queues")
-		value := vhostLimitValueRequest{Value: *limits.MaxQueues}
-		tflog.Debug(ctx, fmt.Sprintf("Vhost limits update path: %s, value: %v\n", path, value))
-		if _, err := s.client.Request(ctx, http.MethodPut, path, value); err != nil {
+// Sync makes the limits of the vhost match limits, current holds the limits
+// last read from the broker. Limits only in current are removed and changed
+// limits are set, in a stable order.
+func (s *VhostLimitsService) Sync(ctx context.Context, vhost string, current, limits map[string]int64) error {
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := limits[name]; ok {
+			continue
+		}
+		if err := s.Delete(ctx, vhost, name); err != nil {
+			return err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(limits)) {
+		if value, ok := current[name]; ok && value == limits[name] {
+			continue
+		}
+		if err := s.Set(ctx, vhost, name, limits[name]); err != nil {
 			return err
 		}
-	} else {
-		_ = s.Delete(ctx, vhost, "max-queues")
 	}
-
 	return nil
 }
 
+// Set sets a single limit of the vhost, e.g. max-connections.
+func (s *VhostLimitsService) Set(ctx context.Context, vhost, limitType string, value int64) error {
+	path := fmt.Sprintf("/api/vhost-limits/%s/%s", url.PathEscape(vhost), url.PathEscape(limitType))
+	_, err := s.client.Request(ctx, http.MethodPut, path, limitValueRequest{Value: value})
+	return err
+}
+
 func (s *VhostLimitsService) Get(ctx context.Context, vhost string) (VhostLimitsResponse, error) {
 	path := fmt.Sprintf("api/vhost-limits/%s", url.PathEscape(vhost))
 	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
@@ -76,9 +106,35 @@ func (s *VhostLimitsService) Get(ctx context.Context, vhost string) (VhostLimits
 	return VhostLimitsResponse{}, nil
 }
 
+// List returns every limit set on the vhost, keyed by limit type.
+func (s *VhostLimitsService) List(ctx context.Context, vhost string) (map[string]int64, error) {
+	path := fmt.Sprintf("api/vhost-limits/%s", url.PathEscape(vhost))
+	resp, err := s.client.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var vhostLimitsResponses []struct {
+		Vhost string           `json:"vhost"`
+		Value map[string]int64 `json:"value"`
+	}
+	if err := json.Unmarshal(body, &vhostLimitsResponses); err != nil {
+		return nil, err
+	}
+	limits := map[string]int64{}
+	for _, v := range vhostLimitsResponses {
+		if v.Vhost == vhost {
+			maps.Copy(limits, v.Value)
+		}
+	}
+	return limits, nil
+}
+
 func (s *VhostLimitsService) Delete(ctx context.Context, vhost, limitType string) error {
 	path := fmt.Sprintf("api/vhost-limits/%s/%s", url.PathEscape(vhost), url.PathEscape(limitType))
 	tflog.Debug(ctx, fmt.Sprintf("Remove limit type: %s for vhost: %s", limitType, vhost))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
@@ -0,0 +1,72 @@
+package clientlibrary
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVhostLimitsSync(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	services := NewServices(client)
+
+	current := map[string]int64{"max-connections": 10, "max-queues": 5, "max-consumers": 1}
+	limits := map[string]int64{"max-connections": 20, "max-queues": 5, "max-channels": 3}
+	if err := services.VhostLimits.Sync(context.Background(), "vhost", current, limits); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"DELETE /api/vhost-limits/vhost/max-consumers",
+		`PUT /api/vhost-limits/vhost/max-channels {"value":3}`,
+		`PUT /api/vhost-limits/vhost/max-connections {"value":20}`,
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestVhostLimitsList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"vhost":"vhost","value":{"max-connections":10,"max-consumers":2}}]`))
+	})
+	services := NewServices(client)
+
+	limits, err := services.VhostLimits.List(context.Background(), "vhost")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(limits) != 2 || limits["max-connections"] != 10 || limits["max-consumers"] != 2 {
+		t.Errorf("limits = %v", limits)
+	}
+}
+
+func TestVhostLimitsUpdateReturnsDeleteErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"access_refused","reason":"Access refused"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	services := NewServices(client)
+
+	err := services.VhostLimits.Update(context.Background(), "vhost", VhostLimits{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("err = %v, want access refused API error", err)
+	}
+}
@@ -3,6 +3,7 @@ package clientlibrary
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,8 +12,17 @@ import (
 
 type VhostsService service
 
+type VhostRequest struct {
+	Description      string `json:"description"`
+	Tags             string `json:"tags"`
+	DefaultQueueType string `json:"default_queue_type,omitempty"`
+}
+
 type VhostResponse struct {
 	Name                   string                    `json:"name"`
+	Description            string                    `json:"description"`
+	Tags                   []string                  `json:"tags"`
+	DefaultQueueType       string                    `json:"default_queue_type"`
 	Dir                    string                    `json:"dir"`
 	Tracing                bool                      `json:"tracing"`
 	Messages               int64                     `json:"messages"`
@@ -32,9 +42,9 @@ type VhostMessageStatsResponse struct {
 	ReturnUnroutable int64 `json:"return_unroutable"`
 }
 
-func (s *VhostsService) CreateOrUpdate(ctx context.Context, name string) error {
+func (s *VhostsService) CreateOrUpdate(ctx context.Context, name string, vhost VhostRequest) error {
 	path := fmt.Sprintf("api/vhosts/%s", url.PathEscape(name))
-	_, err := s.client.Request(ctx, http.MethodPut, path, nil)
+	_, err := s.client.Request(ctx, http.MethodPut, path, vhost)
 	return err
 }
 
@@ -54,12 +64,12 @@ func (s *VhostsService) Get(ctx context.Context, name string) (VhostResponse, er
 
 func (s *VhostsService) List(ctx context.Context) ([]VhostResponse, error) {
 	resp, err := s.client.Request(ctx, http.MethodGet, "api/vhosts", nil)
+	if errors.Is(err, ErrNotFound) {
+		return []VhostResponse{}, nil
+	}
 	if err != nil {
 		return []VhostResponse{}, err
 	}
-	if resp == nil {
-		return []VhostResponse{}, nil
-	}
 
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
@@ -74,5 +84,5 @@ func (s *VhostsService) List(ctx context.Context) ([]VhostResponse, error) {
 func (s *VhostsService) Delete(ctx context.Context, name string) error {
 	path := fmt.Sprintf("api/vhosts/%s", url.PathEscape(name))
 	_, err := s.client.Request(ctx, http.MethodDelete, path, nil)
-	return err
+	return ignoreNotFound(err)
 }
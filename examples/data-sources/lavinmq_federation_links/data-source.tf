@@ -0,0 +1,4 @@
+data "lavinmq_federation_links" "upstream" {
+  vhost    = "/"
+  upstream = "my-upstream"
+}
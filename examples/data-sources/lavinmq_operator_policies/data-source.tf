@@ -0,0 +1,3 @@
+data "lavinmq_operator_policies" "all" {
+  vhost = "/"
+}
@@ -0,0 +1,8 @@
+data "lavinmq_queue" "orders" {
+  name  = "orders"
+  vhost = "/"
+}
+
+output "orders_backlog" {
+  value = data.lavinmq_queue.orders.messages
+}
@@ -1,3 +1,10 @@
 data "lavinmq_queues" "all" {
   vhost = "/"
 }
+
+data "lavinmq_queues" "backlog" {
+  vhost        = "/"
+  name_regex   = "^orders-"
+  state        = "running"
+  min_messages = 1000
+}
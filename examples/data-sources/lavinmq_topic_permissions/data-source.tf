@@ -0,0 +1 @@
+data "lavinmq_topic_permissions" "all" {}
@@ -11,3 +11,87 @@ provider "lavinmq" {
   username = "guest"
   password = "guest"
 }
+
+# Retry requests for longer during broker restarts and rolling upgrades, and
+# give up on a single hung request after 30 seconds.
+provider "lavinmq" {
+  alias           = "retry"
+  baseurl         = "http://localhost:15672"
+  username        = "guest"
+  password        = "guest"
+  request_timeout = "30s"
+
+  retry = {
+    max_attempts     = 10
+    min_backoff      = "1s"
+    max_backoff      = "30s"
+    max_elapsed_time = "5m"
+    status_codes     = [429, 502, 503, 504]
+  }
+}
+
+# Connect to a broker with a certificate issued by an internal CA and
+# authenticate the connection with a client certificate.
+provider "lavinmq" {
+  alias           = "tls"
+  baseurl         = "https://lavinmq.internal:15671"
+  username        = "guest"
+  password        = "guest"
+  ca_cert_file    = "/etc/ssl/internal-ca.pem"
+  client_cert     = file("client.pem")
+  client_key      = file("client.key")
+  tls_server_name = "lavinmq.internal"
+}
+
+# Authenticate with access tokens from an OAuth2 identity provider. The client
+# secret is read from the LAVINMQ_API_OAUTH2_CLIENT_SECRET environment variable.
+provider "lavinmq" {
+  alias   = "oauth2"
+  baseurl = "https://lavinmq.internal:15671"
+
+  auth = {
+    oauth2_client_credentials = {
+      token_url       = "https://idp.example.com/oauth2/token"
+      client_id       = "terraform"
+      scopes          = ["lavinmq.configure:*/*", "lavinmq.tag:administrator"]
+      endpoint_params = { audience = "lavinmq" }
+    }
+  }
+}
+
+# Throttle the requests sent to the management API when managing many
+# resources, to keep the load on the broker down.
+provider "lavinmq" {
+  alias                   = "throttled"
+  baseurl                 = "http://localhost:15672"
+  username                = "guest"
+  password                = "guest"
+  max_requests_per_second = 20
+  max_concurrent_requests = 4
+}
+
+# Manage several clusters with provider aliases reading their settings from
+# named profiles in ~/.lavinmq/credentials.
+provider "lavinmq" {
+  alias   = "staging"
+  profile = "staging"
+}
+
+provider "lavinmq" {
+  alias   = "production"
+  profile = "production"
+}
+
+# Reach the management API through a corporate proxy that routes on an extra
+# header.
+provider "lavinmq" {
+  alias     = "proxy"
+  baseurl   = "https://lavinmq.internal:15671"
+  username  = "guest"
+  password  = "guest"
+  proxy_url = "http://proxy.example.com:3128"
+
+  headers = {
+    "X-Tenant" = "messaging"
+  }
+}
@@ -0,0 +1,2 @@
+# Using Terraform CLI
+terraform import lavinmq_global_parameter.example_global_parameter name
@@ -0,0 +1,4 @@
+import {
+  id = "name"
+  to = lavinmq_global_parameter.example_global_parameter
+}
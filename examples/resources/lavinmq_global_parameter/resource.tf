@@ -0,0 +1,4 @@
+resource "lavinmq_global_parameter" "cluster_name" {
+  name  = "cluster_name"
+  value = jsonencode("example-cluster")
+}
@@ -0,0 +1,2 @@
+# Using Terraform CLI
+terraform import lavinmq_operator_policy.example_operator_policy vhost@operator_policy_name
@@ -0,0 +1,4 @@
+import {
+  id = "vhost@operator_policy_name"
+  to = lavinmq_operator_policy.example_operator_policy
+}
@@ -0,0 +1,15 @@
+resource "lavinmq_vhost" "example" {
+  name = "example-vhost"
+}
+
+resource "lavinmq_operator_policy" "example" {
+  name     = "example-operator-policy"
+  vhost    = lavinmq_vhost.example.name
+  pattern  = ".*"
+  priority = 0
+  apply_to = "queues"
+  definition = {
+    "max-length"  = 100000
+    "message-ttl" = 86400000
+  }
+}
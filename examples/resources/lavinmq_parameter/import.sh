@@ -0,0 +1,2 @@
+# Using Terraform CLI
+terraform import lavinmq_parameter.example_parameter component@vhost@name
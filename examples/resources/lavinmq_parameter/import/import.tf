@@ -0,0 +1,4 @@
+import {
+  id = "component@vhost@name"
+  to = lavinmq_parameter.example_parameter
+}
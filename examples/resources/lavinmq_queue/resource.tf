@@ -11,3 +11,13 @@ resource "lavinmq_queue" "example" {
     "x-message-ttl" = 60000
   }
 }
+
+resource "lavinmq_queue" "stream" {
+  name                          = "example-stream"
+  vhost                         = lavinmq_vhost.example.name
+  durable                       = true
+  queue_type                    = "stream"
+  max_age                       = "7D"
+  max_length_bytes              = 10000000000
+  stream_max_segment_size_bytes = 100000000
+}
@@ -0,0 +1,2 @@
+# Using Terraform CLI
+terraform import lavinmq_topic_permission.example_topic_permission vhost@user@exchange
@@ -0,0 +1,4 @@
+import {
+  id = "vhost@user@exchange"
+  to = lavinmq_topic_permission.example_topic_permission
+}
@@ -0,0 +1,13 @@
+resource "lavinmq_user" "example" {
+  name     = "example-user"
+  password = "example-password"
+  tags     = []
+}
+
+resource "lavinmq_topic_permission" "example" {
+  vhost    = "/"
+  user     = lavinmq_user.example.name
+  exchange = "amq.topic"
+  write    = "^example\\."
+  read     = ".*"
+}
@@ -0,0 +1,2 @@
+# Using Terraform CLI
+terraform import lavinmq_user_limits.example_user_limits user
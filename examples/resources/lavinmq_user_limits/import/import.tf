@@ -0,0 +1,4 @@
+import {
+  id = "user"
+  to = lavinmq_user_limits.example_user_limits
+}
@@ -0,0 +1,11 @@
+resource "lavinmq_user" "example" {
+  name     = "example-user"
+  password = "example-password"
+  tags     = []
+}
+
+resource "lavinmq_user_limits" "example" {
+  user            = lavinmq_user.example.name
+  max_connections = 10
+  max_channels    = 64
+}
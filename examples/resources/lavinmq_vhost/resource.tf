@@ -1,3 +1,5 @@
 resource "lavinmq_vhost" "example" {
-  name = "example-vhost"
+  name        = "example-vhost"
+  description = "Vhost for the example application"
+  tags        = ["example"]
 }
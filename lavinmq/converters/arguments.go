@@ -0,0 +1,201 @@
+package converters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// numberPrecision is the precision Terraform uses for numbers.
+const numberPrecision = 512
+
+// DynamicToMap converts the object of a dynamic attribute, such as arguments
+// or a policy definition, into the map sent to the API. Nested objects and
+// maps become maps and tuples, lists and sets become slices. Integers that fit
+// in an int64 become int64 and other numbers json.Number, so no precision is
+// lost. Attributes with a null value are left out.
+func DynamicToMap(value types.Dynamic) (map[string]any, error) {
+	result := make(map[string]any)
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+
+	switch v := value.UnderlyingValue().(type) {
+	case types.Object:
+		return attributesToMap(v.Attributes())
+	case types.Map:
+		return attributesToMap(v.Elements())
+	default:
+		return nil, fmt.Errorf("expected an object, got %s", value.UnderlyingValue().Type(context.Background()))
+	}
+}
+
+// ValueToAny converts a Terraform value into the value it is encoded as in
+// JSON. A null value becomes nil.
+func ValueToAny(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return numberToAny(v.ValueBigFloat()), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Int32:
+		return int64(v.ValueInt32()), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Float32:
+		return float64(v.ValueFloat32()), nil
+	case types.Object:
+		return attributesToMap(v.Attributes())
+	case types.Map:
+		return attributesToMap(v.Elements())
+	case types.Tuple:
+		return elementsToSlice(v.Elements())
+	case types.List:
+		return elementsToSlice(v.Elements())
+	case types.Set:
+		return elementsToSlice(v.Elements())
+	case types.Dynamic:
+		return ValueToAny(v.UnderlyingValue())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func attributesToMap(attributes map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(attributes))
+	for key, value := range attributes {
+		converted, err := ValueToAny(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if converted != nil {
+			result[key] = converted
+		}
+	}
+	return result, nil
+}
+
+func elementsToSlice(elements []attr.Value) ([]any, error) {
+	result := make([]any, len(elements))
+	for i, element := range elements {
+		converted, err := ValueToAny(element)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+func numberToAny(number *big.Float) any {
+	if number == nil {
+		return nil
+	}
+	if number.IsInt() {
+		if i, accuracy := number.Int64(); accuracy == big.Exact {
+			return i
+		}
+		i, _ := number.Int(nil)
+		return json.Number(i.String())
+	}
+	return json.Number(number.Text('g', -1))
+}
+
+// MapToDynamic converts a map returned by the API, such as arguments or a
+// policy definition, into an object in a dynamic attribute. It is the inverse
+// of DynamicToMap.
+func MapToDynamic(ctx context.Context, values map[string]any) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	object, err := mapToObject(ctx, values)
+	if err != nil {
+		diags.AddError("Failed to convert value", err.Error())
+		return types.DynamicNull(), diags
+	}
+	return types.DynamicValue(object), diags
+}
+
+// AnyToValue converts a value decoded from JSON into a Terraform value.
+// Objects become objects and arrays become tuples, like in the Terraform
+// configuration. Numbers may be json.Number, float64 or any integer type.
+func AnyToValue(ctx context.Context, value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, numberPrecision, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", v, err)
+		}
+		return types.NumberValue(number), nil
+	case float64:
+		return AnyToValue(ctx, json.Number(strconv.FormatFloat(v, 'g', -1, 64)))
+	case int64:
+		return types.NumberValue(new(big.Float).SetPrec(numberPrecision).SetInt64(v)), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetPrec(numberPrecision).SetInt64(int64(v))), nil
+	case map[string]any:
+		return mapToObject(ctx, v)
+	case []any:
+		elements := make([]attr.Value, len(v))
+		elementTypes := make([]attr.Type, len(v))
+		for i, element := range v {
+			converted, err := AnyToValue(ctx, element)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elements[i] = converted
+			elementTypes[i] = converted.Type(ctx)
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid tuple: %v", diags)
+		}
+		return tuple, nil
+	case nil:
+		return nil, fmt.Errorf("null values are not supported")
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// mapToObject converts a map into an object, leaving out null values.
+func mapToObject(ctx context.Context, values map[string]any) (basetypes.ObjectValue, error) {
+	attributes := make(map[string]attr.Value, len(values))
+	attributeTypes := make(map[string]attr.Type, len(values))
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		converted, err := AnyToValue(ctx, value)
+		if err != nil {
+			return basetypes.ObjectValue{}, fmt.Errorf("%s: %w", key, err)
+		}
+		attributes[key] = converted
+		attributeTypes[key] = converted.Type(ctx)
+	}
+	object, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		return basetypes.ObjectValue{}, fmt.Errorf("invalid object: %v", diags)
+	}
+	return object, nil
+}
@@ -0,0 +1,298 @@
+package converters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// terraformNumber parses a number like Terraform does for the configuration.
+func terraformNumber(t testing.TB, s string) types.Number {
+	number, _, err := big.ParseFloat(s, 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NumberValue(number)
+}
+
+// decodeJSON decodes JSON like the client library does for arguments.
+func decodeJSON(t testing.TB, data []byte) map[string]any {
+	var result map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// randomArguments is a random object of arguments as it can be written in the
+// Terraform configuration, with nested objects and tuples.
+type randomArguments struct {
+	value types.Object
+}
+
+func (randomArguments) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(randomArguments{value: randomObject(r, 3)})
+}
+
+func randomObject(r *rand.Rand, depth int) types.Object {
+	attributes := make(map[string]attr.Value)
+	attributeTypes := make(map[string]attr.Type)
+	for i := range r.Intn(5) {
+		key := fmt.Sprintf("x-%s-%d", randomString(r), i)
+		attributes[key] = randomValue(r, depth-1)
+		attributeTypes[key] = attributes[key].Type(context.Background())
+	}
+	return types.ObjectValueMust(attributeTypes, attributes)
+}
+
+func randomValue(r *rand.Rand, depth int) attr.Value {
+	kinds := 3
+	if depth > 0 {
+		kinds = 5
+	}
+	switch r.Intn(kinds) {
+	case 0:
+		return types.StringValue(randomString(r))
+	case 1:
+		return types.BoolValue(r.Intn(2) == 0)
+	case 2:
+		return randomNumber(r)
+	case 3:
+		return randomObject(r, depth)
+	default:
+		elements := make([]attr.Value, r.Intn(4))
+		elementTypes := make([]attr.Type, len(elements))
+		for i := range elements {
+			elements[i] = randomValue(r, depth-1)
+			elementTypes[i] = elements[i].Type(context.Background())
+		}
+		return types.TupleValueMust(elementTypes, elements)
+	}
+}
+
+func randomNumber(r *rand.Rand) types.Number {
+	var s string
+	switch r.Intn(4) {
+	case 0:
+		s = strconv.FormatInt(r.Int63()-r.Int63(), 10)
+	case 1:
+		// Integers beyond int64 and float64 precision.
+		s = strconv.FormatUint(r.Uint64(), 10) + strconv.Itoa(r.Intn(1000000))
+	case 2:
+		s = strconv.FormatFloat(r.NormFloat64()*1e6, 'g', -1, 64)
+	default:
+		// Decimals with more digits than a float64 holds.
+		s = fmt.Sprintf("%d.%d%d", r.Intn(1000), r.Int63(), r.Int63())
+	}
+	number, _, _ := big.ParseFloat(s, 10, numberPrecision, big.ToNearestEven)
+	return types.NumberValue(number)
+}
+
+func randomString(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz-_.#*ÅÄÖ€ "
+	runes := []rune(letters)
+	var b strings.Builder
+	for range r.Intn(12) {
+		b.WriteRune(runes[r.Intn(len(runes))])
+	}
+	return b.String()
+}
+
+// randomJSON is a random JSON object as it can be returned by the API.
+type randomJSON struct {
+	value map[string]any
+}
+
+func (randomJSON) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(randomJSON{value: randomJSONObject(r, 3)})
+}
+
+func randomJSONObject(r *rand.Rand, depth int) map[string]any {
+	object := make(map[string]any)
+	for i := range r.Intn(5) {
+		object[fmt.Sprintf("x-%s-%d", randomString(r), i)] = randomJSONValue(r, depth-1)
+	}
+	return object
+}
+
+func randomJSONValue(r *rand.Rand, depth int) any {
+	kinds := 3
+	if depth > 0 {
+		kinds = 5
+	}
+	switch r.Intn(kinds) {
+	case 0:
+		return randomString(r)
+	case 1:
+		return r.Intn(2) == 0
+	case 2:
+		// Numbers are returned in their shortest form, integers without an
+		// exponent.
+		number := randomNumber(r).ValueBigFloat()
+		if number.IsInt() {
+			i, _ := number.Int(nil)
+			return json.Number(i.String())
+		}
+		return json.Number(number.Text('g', -1))
+	case 3:
+		return randomJSONObject(r, depth)
+	default:
+		elements := make([]any, r.Intn(4))
+		for i := range elements {
+			elements[i] = randomJSONValue(r, depth-1)
+		}
+		return elements
+	}
+}
+
+func TestArgumentsRoundTripFromTerraform(t *testing.T) {
+	ctx := context.Background()
+	property := func(arguments randomArguments) bool {
+		value := types.DynamicValue(arguments.value)
+		encoded, err := DynamicToMap(value)
+		if err != nil {
+			t.Logf("DynamicToMap(%s) error: %v", value, err)
+			return false
+		}
+		data, err := json.Marshal(encoded)
+		if err != nil {
+			t.Logf("json.Marshal(%v) error: %v", encoded, err)
+			return false
+		}
+		decoded, diags := MapToDynamic(ctx, decodeJSON(t, data))
+		if diags.HasError() {
+			t.Logf("MapToDynamic(%s) error: %v", data, diags)
+			return false
+		}
+		if !decoded.Equal(value) {
+			t.Logf("round trip of %s through %s returned %s", value, data, decoded)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestArgumentsRoundTripFromAPI(t *testing.T) {
+	ctx := context.Background()
+	property := func(arguments randomJSON) bool {
+		want, err := json.Marshal(arguments.value)
+		if err != nil {
+			t.Logf("json.Marshal(%v) error: %v", arguments.value, err)
+			return false
+		}
+		value, diags := MapToDynamic(ctx, decodeJSON(t, want))
+		if diags.HasError() {
+			t.Logf("MapToDynamic(%s) error: %v", want, diags)
+			return false
+		}
+		encoded, err := DynamicToMap(value)
+		if err != nil {
+			t.Logf("DynamicToMap(%s) error: %v", value, err)
+			return false
+		}
+		got, err := json.Marshal(encoded)
+		if err != nil {
+			t.Logf("json.Marshal(%v) error: %v", encoded, err)
+			return false
+		}
+		if !bytes.Equal(got, want) {
+			t.Logf("round trip of %s returned %s", want, got)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDynamicToMap(t *testing.T) {
+	headers := types.ObjectValueMust(
+		map[string]attr.Type{
+			"x-match": types.StringType,
+			"formats": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+			"size":    types.NumberType,
+			"ratio":   types.NumberType,
+			"skipped": types.StringType,
+		},
+		map[string]attr.Value{
+			"x-match": types.StringValue("any"),
+			"formats": types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("pdf"), types.StringValue("zip")},
+			),
+			"size":    terraformNumber(t, "9007199254740993"),
+			"ratio":   terraformNumber(t, "0.1"),
+			"skipped": types.StringNull(),
+		},
+	)
+
+	got, err := DynamicToMap(types.DynamicValue(headers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"x-match": "any",
+		"formats": []any{"pdf", "zip"},
+		"size":    int64(9007199254740993),
+		"ratio":   json.Number("0.1"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DynamicToMap() = %#v, want %#v", got, want)
+	}
+
+	if got, err := DynamicToMap(types.DynamicNull()); err != nil || len(got) != 0 {
+		t.Errorf("DynamicToMap(null) = %v, %v, want empty map", got, err)
+	}
+	if _, err := DynamicToMap(types.DynamicValue(types.StringValue("x"))); err == nil {
+		t.Error("DynamicToMap(string) did not return an error")
+	}
+	unknown := types.ObjectValueMust(
+		map[string]attr.Type{"x-message-ttl": types.NumberType},
+		map[string]attr.Value{"x-message-ttl": types.NumberUnknown()},
+	)
+	if _, err := DynamicToMap(types.DynamicValue(unknown)); err == nil {
+		t.Error("DynamicToMap(unknown value) did not return an error")
+	}
+}
+
+func TestAnyToValueNumbers(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"float64", 0.1, "0.1"},
+		{"int64", int64(60000), "60000"},
+		{"json number", json.Number("9007199254740993"), "9007199254740993"},
+		{"large json number", json.Number("123456789012345678901234567890"), "123456789012345678901234567890"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AnyToValue(ctx, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := terraformNumber(t, tt.want); !got.Equal(want) {
+				t.Errorf("AnyToValue(%v) = %s, want %s", tt.value, got, want)
+			}
+		})
+	}
+}
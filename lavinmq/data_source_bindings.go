@@ -102,7 +102,7 @@ func (d *bindingsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	bindings, err := d.services.Bindings.List(ctx, config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve bindings", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve bindings", err)
 		return
 	}
 	if len(bindings) == 0 {
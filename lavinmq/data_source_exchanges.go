@@ -122,7 +122,7 @@ func (d *exchangesDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	exchanges, err := d.services.Exchanges.List(ctx, config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve exchanges", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve exchanges", err)
 		return
 	}
 	if len(exchanges) == 0 {
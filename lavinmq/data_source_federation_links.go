@@ -0,0 +1,152 @@
+package lavinmq
+
+import (
+	"context"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &federationLinksDataSource{}
+	_ datasource.DataSourceWithConfigure = &federationLinksDataSource{}
+)
+
+func NewFederationLinksDataSource() datasource.DataSource {
+	return &federationLinksDataSource{}
+}
+
+type federationLinksDataSource struct {
+	services *clientlibrary.Services
+}
+
+type federationLinksDataSourceModel struct {
+	Vhost           types.String                    `tfsdk:"vhost"`
+	Upstream        types.String                    `tfsdk:"upstream"`
+	FederationLinks []federationLinkDataSourceModel `tfsdk:"federation_links"`
+}
+
+type federationLinkDataSourceModel struct {
+	Upstream         types.String `tfsdk:"upstream"`
+	Vhost            types.String `tfsdk:"vhost"`
+	Type             types.String `tfsdk:"type"`
+	Exchange         types.String `tfsdk:"exchange"`
+	UpstreamExchange types.String `tfsdk:"upstream_exchange"`
+	Queue            types.String `tfsdk:"queue"`
+	UpstreamQueue    types.String `tfsdk:"upstream_queue"`
+	Status           types.String `tfsdk:"status"`
+	Error            types.String `tfsdk:"error"`
+}
+
+func (d *federationLinksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federation_links"
+}
+
+func (d *federationLinksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the status of federation links. Optionally filter by vhost and/or upstream.",
+		Attributes: map[string]schema.Attribute{
+			"vhost": schema.StringAttribute{
+				Description: "The vhost to list federation links from. If not specified, lists all federation links.",
+				Optional:    true,
+			},
+			"upstream": schema.StringAttribute{
+				Description: "Optional: Filter federation links by upstream name.",
+				Optional:    true,
+			},
+			"federation_links": schema.ListNestedAttribute{
+				Description: "List of federation links.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"upstream": schema.StringAttribute{
+							Description: "Name of the federation upstream of the link.",
+							Computed:    true,
+						},
+						"vhost": schema.StringAttribute{
+							Description: "Virtual host of the link.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "What the link federates: 'exchange' or 'queue'.",
+							Computed:    true,
+						},
+						"exchange": schema.StringAttribute{
+							Description: "Name of the local exchange, for exchange federation.",
+							Computed:    true,
+						},
+						"upstream_exchange": schema.StringAttribute{
+							Description: "Name of the upstream exchange, for exchange federation.",
+							Computed:    true,
+						},
+						"queue": schema.StringAttribute{
+							Description: "Name of the local queue, for queue federation.",
+							Computed:    true,
+						},
+						"upstream_queue": schema.StringAttribute{
+							Description: "Name of the upstream queue, for queue federation.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the link: 'starting', 'running', 'shutdown' or 'error'.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Error reported by the broker when the status is 'error'.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *federationLinksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+func (d *federationLinksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config federationLinksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	links, err := d.services.FederationLinks.List(ctx, config.Vhost.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to retrieve federation links", err)
+		return
+	}
+
+	config.FederationLinks = []federationLinkDataSourceModel{}
+
+	for _, link := range links {
+		if !config.Upstream.IsNull() && link.Upstream != config.Upstream.ValueString() {
+			continue
+		}
+		config.FederationLinks = append(config.FederationLinks, federationLinkDataSourceModel{
+			Upstream:         types.StringValue(link.Upstream),
+			Vhost:            types.StringValue(link.Vhost),
+			Type:             types.StringValue(link.Type),
+			Exchange:         types.StringValue(link.Exchange),
+			UpstreamExchange: types.StringValue(link.UpstreamExchange),
+			Queue:            types.StringValue(link.Queue),
+			UpstreamQueue:    types.StringValue(link.UpstreamQueue),
+			Status:           types.StringValue(link.Status),
+			Error:            types.StringValue(link.Error),
+		})
+	}
+	if len(config.FederationLinks) == 0 {
+		tflog.Warn(ctx, "No federation links found")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
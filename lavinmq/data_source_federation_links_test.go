@@ -0,0 +1,74 @@
+package lavinmq
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceFederationLinks_Basic(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.lavinmq_federation_links.links"
+
+	// Set sanitized value for playback and use real value for recording
+	testUpstreamURI := "TEST_AMQP_URI"
+	if os.Getenv("LAVINMQ_RECORD") != "" {
+		testUpstreamURI = os.Getenv("TEST_AMQP_URI")
+	}
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "lavinmq_exchange" "local_exchange" {
+							name        = "test_fed_links_exchange"
+							vhost       = "/"
+							type        = "topic"
+							auto_delete = false
+							durable     = true
+					}
+
+					resource "lavinmq_policy" "federation_policy" {
+							name       = "test_fed_links_policy"
+							vhost      = "/"
+							pattern    = "^test_fed_links_.*"
+							apply_to   = "exchanges"
+							definition = {
+									"federation-upstream" = "vcr_test_federation_links"
+							}
+
+							depends_on = [lavinmq_exchange.local_exchange]
+					}
+
+					resource "lavinmq_federation_upstream" "test" {
+							name             = "vcr_test_federation_links"
+							vhost            = "/"
+							uri              = "%[1]s"
+							exchange         = "federated-exchange"
+							wait_for_running = true
+
+							depends_on = [lavinmq_policy.federation_policy]
+					}
+
+					data "lavinmq_federation_links" "links" {
+							vhost    = "/"
+							upstream = lavinmq_federation_upstream.test.name
+					}`, testUpstreamURI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lavinmq_federation_upstream.test", "wait_for_running", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.upstream", "vcr_test_federation_links"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.type", "exchange"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.exchange", "test_fed_links_exchange"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.upstream_exchange", "federated-exchange"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.status", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "federation_links.0.error", ""),
+				),
+			},
+		},
+	})
+}
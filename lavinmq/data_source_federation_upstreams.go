@@ -132,7 +132,7 @@ func (d *federationUpstreamsDataSource) Read(ctx context.Context, req datasource
 
 	parameters, err := d.services.Parameters.List(ctx, "federation-upstream", config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve federation upstreams", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve federation upstreams", err)
 		return
 	}
 	if len(parameters) == 0 {
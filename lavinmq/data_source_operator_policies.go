@@ -0,0 +1,154 @@
+package lavinmq
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &operatorPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &operatorPoliciesDataSource{}
+)
+
+func NewOperatorPoliciesDataSource() datasource.DataSource {
+	return &operatorPoliciesDataSource{}
+}
+
+type operatorPoliciesDataSource struct {
+	services *clientlibrary.Services
+}
+
+type operatorPoliciesDataSourceModel struct {
+	Vhost            types.String                    `tfsdk:"vhost"`
+	OperatorPolicies []operatorPolicyDataSourceModel `tfsdk:"operator_policies"`
+}
+
+type operatorPolicyDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Vhost      types.String `tfsdk:"vhost"`
+	Pattern    types.String `tfsdk:"pattern"`
+	Definition types.Map    `tfsdk:"definition"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	ApplyTo    types.String `tfsdk:"apply_to"`
+}
+
+func (d *operatorPoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operator_policies"
+}
+
+func (d *operatorPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List operator policies. Optionally filter by vhost.",
+		Attributes: map[string]schema.Attribute{
+			"vhost": schema.StringAttribute{
+				Description: "The vhost to list operator policies from.",
+				Optional:    true,
+			},
+			"operator_policies": schema.ListNestedAttribute{
+				Description: "List of operator policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the operator policy.",
+							Computed:    true,
+						},
+						"vhost": schema.StringAttribute{
+							Description: "Virtual host where the operator policy is applied.",
+							Computed:    true,
+						},
+						"pattern": schema.StringAttribute{
+							Description: "Regular expression pattern that matches the names of queues to which the operator policy applies.",
+							Computed:    true,
+						},
+						"definition": schema.MapAttribute{
+							Description: "Operator policy definition as a map of key-value pairs.",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Operator policy priority. Higher numbers indicate higher priority.",
+							Computed:    true,
+						},
+						"apply_to": schema.StringAttribute{
+							Description: "What the operator policy applies to, operator policies only apply to 'queues'.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *operatorPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+func (d *operatorPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config operatorPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.services.OperatorPolicies.List(ctx, config.Vhost.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to retrieve operator policies", err)
+		return
+	}
+	if len(policies) == 0 {
+		tflog.Warn(ctx, "No operator policies found")
+	}
+
+	var state operatorPoliciesDataSourceModel
+	state.Vhost = config.Vhost
+	state.OperatorPolicies = []operatorPolicyDataSourceModel{}
+
+	for _, policy := range policies {
+		definition, diags := types.MapValueFrom(ctx, types.Int64Type, operatorPolicyDefinition(policy.Definition))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.OperatorPolicies = append(state.OperatorPolicies, operatorPolicyDataSourceModel{
+			Name:       types.StringValue(policy.Name),
+			Vhost:      types.StringValue(policy.Vhost),
+			Pattern:    types.StringValue(policy.Pattern),
+			Definition: definition,
+			Priority:   types.Int64Value(int64(policy.Priority)),
+			ApplyTo:    types.StringValue(policy.ApplyTo),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// operatorPolicyDefinition returns the integer values of an operator policy
+// definition, which are the only values operator policies allow.
+func operatorPolicyDefinition(definition map[string]any) map[string]int64 {
+	result := make(map[string]int64, len(definition))
+	for key, value := range definition {
+		switch v := value.(type) {
+		case int64:
+			result[key] = v
+		case float64:
+			result[key] = int64(v)
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				result[key] = i
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceOperatorPolicies_Basic(t *testing.T) {
+	t.Parallel()
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "test" {
+            name     = "terraform-operator-policy-test"
+            vhost    = "/"
+            pattern  = "^test"
+            apply_to = "queues"
+            definition = {
+              "max-length" = 100
+            }
+          }
+
+          data "lavinmq_operator_policies" "all" {
+            vhost      = "/"
+            depends_on = [lavinmq_operator_policy.test]
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lavinmq_operator_policies.all", "vhost", "/"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lavinmq_operator_policies.all", "operator_policies.*", map[string]string{
+						"name":                  "terraform-operator-policy-test",
+						"vhost":                 "/",
+						"pattern":               "^test",
+						"apply_to":              "queues",
+						"priority":              "0",
+						"definition.max-length": "100",
+					}),
+				),
+			},
+		},
+	})
+}
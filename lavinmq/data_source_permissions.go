@@ -2,6 +2,7 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -107,8 +108,8 @@ func (d *permissionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if !config.Vhost.IsNull() && !config.User.IsNull() {
 		// Both vhost and user specified - get single permission
 		permission, err := d.services.Permissions.Get(ctx, config.Vhost.ValueString(), config.User.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Unable to retrieve permission", err.Error())
+		if err != nil && !errors.Is(err, clientlibrary.ErrNotFound) {
+			addAPIError(&resp.Diagnostics, "Unable to retrieve permission", err)
 			return
 		}
 		if permission != nil {
@@ -127,7 +128,7 @@ func (d *permissionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve permissions", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve permissions", err)
 		return
 	}
 	if len(permissions) == 0 {
@@ -96,7 +96,7 @@ func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	policies, err := d.services.Policies.List(ctx, config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve policies", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve policies", err)
 		return
 	}
 	if len(policies) == 0 {
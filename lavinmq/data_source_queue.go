@@ -0,0 +1,339 @@
+package lavinmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &queueDataSource{}
+	_ datasource.DataSourceWithConfigure = &queueDataSource{}
+)
+
+func NewQueueDataSource() datasource.DataSource {
+	return &queueDataSource{}
+}
+
+type queueDataSource struct {
+	services *clientlibrary.Services
+}
+
+type queueDetailsDataSourceModel struct {
+	Name                      types.String                `tfsdk:"name"`
+	Vhost                     types.String                `tfsdk:"vhost"`
+	AutoDelete                types.Bool                  `tfsdk:"auto_delete"`
+	Durable                   types.Bool                  `tfsdk:"durable"`
+	QueueType                 types.String                `tfsdk:"queue_type"`
+	State                     types.String                `tfsdk:"state"`
+	Arguments                 types.Dynamic               `tfsdk:"arguments"`
+	Policy                    types.String                `tfsdk:"policy"`
+	OperatorPolicy            types.String                `tfsdk:"operator_policy"`
+	EffectivePolicyDefinition types.Dynamic               `tfsdk:"effective_policy_definition"`
+	EffectiveArguments        []types.String              `tfsdk:"effective_arguments"`
+	Consumers                 types.Int64                 `tfsdk:"consumers"`
+	Messages                  types.Int64                 `tfsdk:"messages"`
+	Ready                     types.Int64                 `tfsdk:"ready"`
+	Unacked                   types.Int64                 `tfsdk:"unacked"`
+	MessageBytes              types.Int64                 `tfsdk:"message_bytes"`
+	ReadyBytes                types.Int64                 `tfsdk:"ready_bytes"`
+	UnackedBytes              types.Int64                 `tfsdk:"unacked_bytes"`
+	MessageStats              *queueMessageStatsModel     `tfsdk:"message_stats"`
+	ConsumerDetails           []queueConsumerDetailsModel `tfsdk:"consumer_details"`
+}
+
+type queueMessageStatsModel struct {
+	Publish        types.Int64   `tfsdk:"publish"`
+	PublishRate    types.Float64 `tfsdk:"publish_rate"`
+	DeliverGet     types.Int64   `tfsdk:"deliver_get"`
+	DeliverGetRate types.Float64 `tfsdk:"deliver_get_rate"`
+	Ack            types.Int64   `tfsdk:"ack"`
+	AckRate        types.Float64 `tfsdk:"ack_rate"`
+	Redeliver      types.Int64   `tfsdk:"redeliver"`
+	RedeliverRate  types.Float64 `tfsdk:"redeliver_rate"`
+	Reject         types.Int64   `tfsdk:"reject"`
+	RejectRate     types.Float64 `tfsdk:"reject_rate"`
+}
+
+type queueConsumerDetailsModel struct {
+	ConsumerTag   types.String `tfsdk:"consumer_tag"`
+	Channel       types.String `tfsdk:"channel"`
+	Connection    types.String `tfsdk:"connection"`
+	PeerHost      types.String `tfsdk:"peer_host"`
+	User          types.String `tfsdk:"user"`
+	Exclusive     types.Bool   `tfsdk:"exclusive"`
+	AckRequired   types.Bool   `tfsdk:"ack_required"`
+	PrefetchCount types.Int64  `tfsdk:"prefetch_count"`
+}
+
+func (d *queueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue"
+}
+
+func (d *queueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the details and statistics of a queue.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the queue.",
+				Required:    true,
+			},
+			"vhost": schema.StringAttribute{
+				Description: "The vhost the queue is located in.",
+				Required:    true,
+			},
+			"auto_delete": schema.BoolAttribute{
+				Description: "Whether the queue is automatically deleted when no longer used.",
+				Computed:    true,
+			},
+			"durable": schema.BoolAttribute{
+				Description: "Whether the queue survives a broker restart.",
+				Computed:    true,
+			},
+			"queue_type": schema.StringAttribute{
+				Description: "Type of the queue: 'classic' or 'stream'. Not set when the queue was declared without x-queue-type, its type then follows the default queue type of the vhost.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the queue: 'running', 'paused', 'flow', 'closed', or 'deleted'.",
+				Computed:    true,
+			},
+			"arguments": schema.DynamicAttribute{
+				Description: "Arguments the queue was declared with.",
+				Computed:    true,
+			},
+			"policy": schema.StringAttribute{
+				Description: "Name of the policy applied to the queue, if any.",
+				Computed:    true,
+			},
+			"operator_policy": schema.StringAttribute{
+				Description: "Name of the operator policy applied to the queue, if any.",
+				Computed:    true,
+			},
+			"effective_policy_definition": schema.DynamicAttribute{
+				Description: "Definition of the policies in effect for the queue.",
+				Computed:    true,
+			},
+			"effective_arguments": schema.ListAttribute{
+				Description: "Names of the arguments in effect for the queue, as reported by the broker.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"consumers": schema.Int64Attribute{
+				Description: "Number of consumers subscribed to the queue.",
+				Computed:    true,
+			},
+			"messages": schema.Int64Attribute{
+				Description: "Number of messages in the queue.",
+				Computed:    true,
+			},
+			"ready": schema.Int64Attribute{
+				Description: "Number of messages ready to be delivered to consumers.",
+				Computed:    true,
+			},
+			"unacked": schema.Int64Attribute{
+				Description: "Number of messages delivered to consumers but not yet acknowledged.",
+				Computed:    true,
+			},
+			"message_bytes": schema.Int64Attribute{
+				Description: "Total size of the message bodies in the queue in bytes.",
+				Computed:    true,
+			},
+			"ready_bytes": schema.Int64Attribute{
+				Description: "Size of the message bodies ready to be delivered in bytes.",
+				Computed:    true,
+			},
+			"unacked_bytes": schema.Int64Attribute{
+				Description: "Size of the message bodies not yet acknowledged in bytes.",
+				Computed:    true,
+			},
+			"message_stats": schema.SingleNestedAttribute{
+				Description: "Message counters since the queue was declared, and their current rates per second.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"publish": schema.Int64Attribute{
+						Description: "Number of messages published to the queue.",
+						Computed:    true,
+					},
+					"publish_rate": schema.Float64Attribute{
+						Description: "Messages published to the queue per second.",
+						Computed:    true,
+					},
+					"deliver_get": schema.Int64Attribute{
+						Description: "Number of messages delivered to consumers or fetched with basic.get.",
+						Computed:    true,
+					},
+					"deliver_get_rate": schema.Float64Attribute{
+						Description: "Messages delivered or fetched per second.",
+						Computed:    true,
+					},
+					"ack": schema.Int64Attribute{
+						Description: "Number of messages acknowledged.",
+						Computed:    true,
+					},
+					"ack_rate": schema.Float64Attribute{
+						Description: "Messages acknowledged per second.",
+						Computed:    true,
+					},
+					"redeliver": schema.Int64Attribute{
+						Description: "Number of messages redelivered.",
+						Computed:    true,
+					},
+					"redeliver_rate": schema.Float64Attribute{
+						Description: "Messages redelivered per second.",
+						Computed:    true,
+					},
+					"reject": schema.Int64Attribute{
+						Description: "Number of messages rejected.",
+						Computed:    true,
+					},
+					"reject_rate": schema.Float64Attribute{
+						Description: "Messages rejected per second.",
+						Computed:    true,
+					},
+				},
+			},
+			"consumer_details": schema.ListNestedAttribute{
+				Description: "Consumers subscribed to the queue.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"consumer_tag": schema.StringAttribute{
+							Description: "Consumer tag.",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "Name of the channel the consumer is on.",
+							Computed:    true,
+						},
+						"connection": schema.StringAttribute{
+							Description: "Name of the connection the consumer is on.",
+							Computed:    true,
+						},
+						"peer_host": schema.StringAttribute{
+							Description: "Host the connection of the consumer comes from.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "User of the connection of the consumer.",
+							Computed:    true,
+						},
+						"exclusive": schema.BoolAttribute{
+							Description: "Whether the consumer is exclusive.",
+							Computed:    true,
+						},
+						"ack_required": schema.BoolAttribute{
+							Description: "Whether the consumer acknowledges messages.",
+							Computed:    true,
+						},
+						"prefetch_count": schema.Int64Attribute{
+							Description: "Prefetch count of the consumer, 0 when unlimited.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *queueDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+func (d *queueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config queueDetailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := d.services.Queues.Get(ctx, config.Vhost.ValueString(), config.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		resp.Diagnostics.AddError("Queue not found",
+			fmt.Sprintf("Queue %q does not exist in vhost %q.", config.Name.ValueString(), config.Vhost.ValueString()))
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to retrieve queue", err)
+		return
+	}
+
+	state := queueDetailsDataSourceModel{
+		Name:           config.Name,
+		Vhost:          config.Vhost,
+		AutoDelete:     types.BoolValue(queue.AutoDelete),
+		Durable:        types.BoolValue(queue.Durable),
+		QueueType:      queueTypeValue(queue.Arguments),
+		State:          types.StringValue(queue.State),
+		Policy:         optionalString(queue.Policy),
+		OperatorPolicy: optionalString(queue.OperatorPolicy),
+		Consumers:      types.Int64Value(queue.Consumers),
+		Messages:       types.Int64Value(queue.Messages),
+		Ready:          types.Int64Value(queue.Ready),
+		Unacked:        types.Int64Value(queue.Unacked),
+		MessageBytes:   types.Int64Value(queue.TotalBytes),
+		ReadyBytes:     types.Int64Value(queue.ReadyBytes),
+		UnackedBytes:   types.Int64Value(queue.UnackedBytes),
+		MessageStats: &queueMessageStatsModel{
+			Publish:        types.Int64Value(queue.MessageStats.Publish),
+			PublishRate:    types.Float64Value(queue.MessageStats.PublishDetails.Rate),
+			DeliverGet:     types.Int64Value(queue.MessageStats.DeliverGet),
+			DeliverGetRate: types.Float64Value(queue.MessageStats.DeliverGetDetails.Rate),
+			Ack:            types.Int64Value(queue.MessageStats.Ack),
+			AckRate:        types.Float64Value(queue.MessageStats.AckDetails.Rate),
+			Redeliver:      types.Int64Value(queue.MessageStats.Redeliver),
+			RedeliverRate:  types.Float64Value(queue.MessageStats.RedeliverDetails.Rate),
+			Reject:         types.Int64Value(queue.MessageStats.Reject),
+			RejectRate:     types.Float64Value(queue.MessageStats.RejectDetails.Rate),
+		},
+		EffectiveArguments: []types.String{},
+		ConsumerDetails:    []queueConsumerDetailsModel{},
+	}
+
+	arguments, diags := converters.MapToDynamic(ctx, queue.Arguments)
+	resp.Diagnostics.Append(diags...)
+	state.Arguments = arguments
+	definition, diags := converters.MapToDynamic(ctx, queue.EffectivePolicyDefinition)
+	resp.Diagnostics.Append(diags...)
+	state.EffectivePolicyDefinition = definition
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, argument := range queue.EffectiveArguments {
+		state.EffectiveArguments = append(state.EffectiveArguments, types.StringValue(argument))
+	}
+	for _, consumer := range queue.ConsumerDetails {
+		state.ConsumerDetails = append(state.ConsumerDetails, queueConsumerDetailsModel{
+			ConsumerTag:   types.StringValue(consumer.ConsumerTag),
+			Channel:       types.StringValue(consumer.ChannelDetails.Name),
+			Connection:    types.StringValue(consumer.ChannelDetails.ConnectionName),
+			PeerHost:      types.StringValue(consumer.ChannelDetails.PeerHost),
+			User:          types.StringValue(consumer.ChannelDetails.User),
+			Exclusive:     types.BoolValue(consumer.Exclusive),
+			AckRequired:   types.BoolValue(consumer.AckRequired),
+			PrefetchCount: types.Int64Value(consumer.PrefetchCount),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// optionalString returns a null string for an empty value.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,75 @@
+package lavinmq
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceQueue_Basic(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.lavinmq_queue.test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test" {
+            name        = "vcr_test_data_source_queue"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+            arguments = {
+              x-message-ttl = 60000
+            }
+          }
+
+          data "lavinmq_queue" "test" {
+            name  = lavinmq_queue.test.name
+            vhost = lavinmq_queue.test.vhost
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "vcr_test_data_source_queue"),
+					resource.TestCheckResourceAttr(dataSourceName, "durable", "true"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "queue_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "arguments.x-message-ttl", "60000"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", "vcr_test_ttl_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "effective_policy_definition.max-length", "1000"),
+					resource.TestCheckResourceAttr(dataSourceName, "messages", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "ready", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "unacked", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "message_bytes", "42"),
+					resource.TestCheckResourceAttr(dataSourceName, "message_stats.publish", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "message_stats.publish_rate", "0.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumer_details.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumer_details.0.consumer_tag", "amq.ctag-vcr"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumer_details.0.prefetch_count", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceQueue_NotFound(t *testing.T) {
+	t.Parallel()
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          data "lavinmq_queue" "test" {
+            name  = "vcr_test_data_source_queue_missing"
+            vhost = "/"
+          }`,
+				ExpectError: regexp.MustCompile(`Queue not found`),
+			},
+		},
+	})
+}
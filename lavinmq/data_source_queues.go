@@ -2,10 +2,15 @@ package lavinmq
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -36,8 +41,11 @@ type queueDataSourceModel struct {
 }
 
 type queuesDataSourceModel struct {
-	Vhost  types.String           `tfsdk:"vhost"`
-	Queues []queueDataSourceModel `tfsdk:"queues"`
+	Vhost       types.String           `tfsdk:"vhost"`
+	NameRegex   types.String           `tfsdk:"name_regex"`
+	State       types.String           `tfsdk:"state"`
+	MinMessages types.Int64            `tfsdk:"min_messages"`
+	Queues      []queueDataSourceModel `tfsdk:"queues"`
 }
 
 func (d *queuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,12 +54,30 @@ func (d *queuesDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *queuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "List queues in a vhost.",
+		Description: "List queues in a vhost. Optionally filter by name, state and number of messages.",
 		Attributes: map[string]schema.Attribute{
 			"vhost": schema.StringAttribute{
 				Description: "The vhost to list queues from.",
 				Optional:    true,
 			},
+			"name_regex": schema.StringAttribute{
+				Description: "Optional: Only list queues with a name that matches the regular expression.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Optional: Only list queues in the state: 'running', 'paused', 'flow', 'closed', or 'deleted'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("running", "paused", "flow", "closed", "deleted"),
+				},
+			},
+			"min_messages": schema.Int64Attribute{
+				Description: "Optional: Only list queues with at least this number of messages.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"queues": schema.ListNestedAttribute{
 				Description: "List of queues in the vhost.",
 				Computed:    true,
@@ -117,18 +143,39 @@ func (d *queuesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	queues, err := d.services.Queues.List(ctx, config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve queues", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve queues", err)
 		return
 	}
 	if len(queues) == 0 {
 		tflog.Warn(ctx, "No queues found")
 	}
 
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
 	var state queuesDataSourceModel
 	state.Vhost = config.Vhost
+	state.NameRegex = config.NameRegex
+	state.State = config.State
+	state.MinMessages = config.MinMessages
 	state.Queues = []queueDataSourceModel{}
 
 	for _, queue := range queues {
+		if nameRegex != nil && !nameRegex.MatchString(queue.Name) {
+			continue
+		}
+		if !config.State.IsNull() && queue.State != config.State.ValueString() {
+			continue
+		}
+		if !config.MinMessages.IsNull() && queue.Messages < config.MinMessages.ValueInt64() {
+			continue
+		}
 		state.Queues = append(state.Queues, queueDataSourceModel{
 			Name:       types.StringValue(queue.Name),
 			Vhost:      types.StringValue(queue.Vhost),
@@ -70,6 +70,30 @@ func TestAccDataSourceQueues_NonExistingVhost(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceQueues_Filters(t *testing.T) {
+	t.Parallel()
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          data "lavinmq_queues" "filtered" {
+            vhost        = "/"
+            name_regex   = "^vcr_test_filter_"
+            state        = "running"
+            min_messages = 10
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lavinmq_queues.filtered", "queues.#", "1"),
+					resource.TestCheckResourceAttr("data.lavinmq_queues.filtered", "queues.0.name", "vcr_test_filter_backlog"),
+					resource.TestCheckResourceAttr("data.lavinmq_queues.filtered", "queues.0.messages", "25"),
+				),
+			},
+		},
+	})
+}
+
 // The following test is commented out becuse it's not working in CI environment.
 // Playback works fine running on three local environments and codespaces.
 // Error received: Error running post-apply refresh plan when reading both queues.
@@ -142,7 +142,7 @@ func (d *shovelsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	parameters, err := d.services.Parameters.List(ctx, "shovel", config.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve shovels", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve shovels", err)
 		return
 	}
 	if len(parameters) == 0 {
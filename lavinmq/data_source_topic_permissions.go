@@ -0,0 +1,134 @@
+package lavinmq
+
+import (
+	"context"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &topicPermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &topicPermissionsDataSource{}
+)
+
+func NewTopicPermissionsDataSource() datasource.DataSource {
+	return &topicPermissionsDataSource{}
+}
+
+type topicPermissionsDataSource struct {
+	services *clientlibrary.Services
+}
+
+type topicPermissionsDataSourceModel struct {
+	Vhost            types.String                     `tfsdk:"vhost"`
+	User             types.String                     `tfsdk:"user"`
+	TopicPermissions []topicPermissionDataSourceModel `tfsdk:"topic_permissions"`
+}
+
+type topicPermissionDataSourceModel struct {
+	Vhost    types.String `tfsdk:"vhost"`
+	User     types.String `tfsdk:"user"`
+	Exchange types.String `tfsdk:"exchange"`
+	Write    types.String `tfsdk:"write"`
+	Read     types.String `tfsdk:"read"`
+}
+
+func (d *topicPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topic_permissions"
+}
+
+func (d *topicPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List topic permissions. Optionally filter by vhost and/or user.",
+		Attributes: map[string]schema.Attribute{
+			"vhost": schema.StringAttribute{
+				Description: "Optional: Filter topic permissions by vhost.",
+				Optional:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "Optional: Filter topic permissions by user.",
+				Optional:    true,
+			},
+			"topic_permissions": schema.ListNestedAttribute{
+				Description: "List of topic permissions.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vhost": schema.StringAttribute{
+							Description: "Virtual host where the permission is applied.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "Name of the user.",
+							Computed:    true,
+						},
+						"exchange": schema.StringAttribute{
+							Description: "Name of the topic exchange the permission is applied to.",
+							Computed:    true,
+						},
+						"write": schema.StringAttribute{
+							Description: "Regular expression pattern for routing keys the user can publish with.",
+							Computed:    true,
+						},
+						"read": schema.StringAttribute{
+							Description: "Regular expression pattern for routing keys the user can bind with.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *topicPermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+func (d *topicPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config topicPermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vhost := ""
+	user := ""
+	if !config.Vhost.IsNull() {
+		vhost = config.Vhost.ValueString()
+	}
+	if !config.User.IsNull() {
+		user = config.User.ValueString()
+	}
+
+	permissions, err := d.services.TopicPermissions.List(ctx, vhost, user)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to retrieve topic permissions", err)
+		return
+	}
+	if len(permissions) == 0 {
+		tflog.Warn(ctx, "No topic permissions found")
+	}
+
+	config.TopicPermissions = []topicPermissionDataSourceModel{}
+
+	for _, permission := range permissions {
+		config.TopicPermissions = append(config.TopicPermissions, topicPermissionDataSourceModel{
+			Vhost:    types.StringValue(permission.Vhost),
+			User:     types.StringValue(permission.User),
+			Exchange: types.StringValue(permission.Exchange),
+			Write:    types.StringValue(permission.Write),
+			Read:     types.StringValue(permission.Read),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
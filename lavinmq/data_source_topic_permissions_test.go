@@ -0,0 +1,48 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceTopicPermissions_FilterByUser(t *testing.T) {
+	t.Parallel()
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_user" "test_user" {
+            name     = "terraform-topic-filter-user"
+            password = "test-password"
+          }
+
+          resource "lavinmq_topic_permission" "test" {
+            vhost    = "/"
+            user     = lavinmq_user.test_user.name
+            exchange = "amq.topic"
+            write    = "^test\\."
+            read     = ".*"
+          }
+
+          data "lavinmq_topic_permissions" "filtered" {
+            user       = lavinmq_user.test_user.name
+            depends_on = [lavinmq_topic_permission.test]
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lavinmq_topic_permissions.filtered", "user", "terraform-topic-filter-user"),
+					resource.TestCheckResourceAttr("data.lavinmq_topic_permissions.filtered", "topic_permissions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lavinmq_topic_permissions.filtered", "topic_permissions.*", map[string]string{
+						"vhost":    "/",
+						"user":     "terraform-topic-filter-user",
+						"exchange": "amq.topic",
+						"write":    "^test\\.",
+						"read":     ".*",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -76,7 +76,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	users, err := d.services.Users.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve users", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve users", err)
 		return
 	}
 	if len(users) == 0 {
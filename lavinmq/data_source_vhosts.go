@@ -64,7 +64,7 @@ func (d *vhostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	vhosts, err := d.services.Vhosts.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve vhosts", err.Error())
+		addAPIError(&resp.Diagnostics, "Unable to retrieve vhosts", err)
 		return
 	}
 	if len(vhosts) == 0 {
@@ -0,0 +1,41 @@
+package lavinmq
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// addAPIError adds an error diagnostic for a failed API call. Known API errors
+// get a detail explaining the likely cause in addition to the broker reason.
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(summary, apiErrorDetail(err))
+}
+
+func apiErrorDetail(err error) string {
+	var apiErr *clientlibrary.APIError
+	if !errors.As(err, &apiErr) {
+		return err.Error()
+	}
+
+	var hint string
+	switch {
+	case errors.Is(err, clientlibrary.ErrBadRequest):
+		hint = "The broker rejected the request as invalid. Verify the configured values."
+	case errors.Is(err, clientlibrary.ErrUnauthorized):
+		hint = "The broker rejected the provider credentials. Verify the configured username and password."
+	case errors.Is(err, clientlibrary.ErrForbidden):
+		hint = "The provider user is not allowed to perform this operation. Verify the user tags and vhost permissions."
+	case errors.Is(err, clientlibrary.ErrNotFound):
+		hint = "The object, or the vhost it belongs to, does not exist on the broker."
+	case errors.Is(err, clientlibrary.ErrConflict):
+		hint = "The request conflicts with the current state of the object on the broker."
+	case errors.Is(err, clientlibrary.ErrServerError):
+		hint = "The broker failed to process the request. It might be restarting or overloaded, try again later."
+	default:
+		return err.Error()
+	}
+	return fmt.Sprintf("%s\n\n%s", hint, err.Error())
+}
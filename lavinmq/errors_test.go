@@ -0,0 +1,57 @@
+package lavinmq
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+)
+
+func TestAPIErrorDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{
+			name: "unauthorized",
+			err:  &clientlibrary.APIError{Method: "GET", Path: "/api/users/test", StatusCode: 401, Reason: "Login failed"},
+			want: []string{"Verify the configured username and password", "status code: 401, error: Login failed"},
+		},
+		{
+			name: "wrapped forbidden",
+			err:  fmt.Errorf("wrapped: %w", &clientlibrary.APIError{Method: "PUT", Path: "/api/vhosts/test", StatusCode: 403}),
+			want: []string{"Verify the user tags and vhost permissions", "status code: 403"},
+		},
+		{
+			name: "unknown status",
+			err:  &clientlibrary.APIError{Method: "GET", Path: "/api/queues", StatusCode: 418, Reason: "teapot"},
+			want: []string{"GET /api/queues failed, status code: 418, error: teapot"},
+		},
+		{
+			name: "joined errors",
+			err: errors.Join(
+				fmt.Errorf("failed to restore events: %w", &clientlibrary.APIError{Method: "PUT", Path: "/api/topic-permissions/%2F/user", StatusCode: 503}),
+				fmt.Errorf("failed to restore logs: %w", &clientlibrary.APIError{Method: "PUT", Path: "/api/topic-permissions/%2F/user", StatusCode: 503}),
+			),
+			want: []string{"try again later", "failed to restore events", "failed to restore logs"},
+		},
+		{
+			name: "transport error",
+			err:  errors.New("connection refused"),
+			want: []string{"connection refused"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := apiErrorDetail(tt.err)
+			for _, want := range tt.want {
+				if !strings.Contains(detail, want) {
+					t.Errorf("detail %q does not contain %q", detail, want)
+				}
+			}
+		})
+	}
+}
@@ -5,13 +5,19 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,6 +32,10 @@ func New(v string, httpClient *http.Client) provider.Provider {
 	return &lavinmqProvider{version: v, httpClient: httpClient}
 }
 
+// defaultRequestTimeout bounds every request to the API unless the provider
+// configuration sets request_timeout.
+const defaultRequestTimeout = time.Minute
+
 // lavinmqProvider is the provider implementation.
 type lavinmqProvider struct {
 	version    string
@@ -34,9 +44,39 @@ type lavinmqProvider struct {
 
 // lavinmqProviderModel maps provider schema data to a Go type.
 type lavinmqProviderModel struct {
-	BaseURL  types.String `tfsdk:"baseurl"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	BaseURL  types.String        `tfsdk:"baseurl"`
+	Username types.String        `tfsdk:"username"`
+	Password types.String        `tfsdk:"password"`
+	Auth     *providerAuthModel  `tfsdk:"auth"`
+	Retry    *providerRetryModel `tfsdk:"retry"`
+
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	ProxyURL types.String `tfsdk:"proxy_url"`
+	Headers  types.Map    `tfsdk:"headers"`
+
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	MaxRequestsPerSecond  types.Int64  `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+
+	PreventDestroyWhenNonEmpty types.Bool `tfsdk:"prevent_destroy_when_non_empty"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	TLSServerName      types.String `tfsdk:"tls_server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// providerRetryModel maps the retry policy block of the provider schema.
+type providerRetryModel struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff     types.String `tfsdk:"min_backoff"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+	MaxElapsedTime types.String `tfsdk:"max_elapsed_time"`
+	StatusCodes    types.List   `tfsdk:"status_codes"`
 }
 
 // Metadata returns the provider type name.
@@ -62,6 +102,173 @@ func (p *lavinmqProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "Name of a profile in the credentials file to read the API settings from. " +
+					"Attributes set in the configuration take precedence over the profile, and the profile over the other " +
+					"`LAVINMQ_API_*` environment variables. Can also be set with the `LAVINMQ_API_PROFILE` environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to the credentials file with the profiles, in INI or YAML format. Defaults to `~/.lavinmq/credentials`. " +
+					"Can also be set with the `LAVINMQ_API_CREDENTIALS_FILE` environment variable.",
+				Optional: true,
+			},
+			"auth": schema.SingleNestedAttribute{
+				Description: "Token based authentication to the API, used instead of username and password. " +
+					"Without this block a bearer token can be set with the `LAVINMQ_API_TOKEN` environment variable.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"bearer_token": schema.StringAttribute{
+						Description: "Static bearer token, e.g. a JWT. Can also be set with the `LAVINMQ_API_TOKEN` environment variable.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("oauth2_client_credentials")),
+						},
+					},
+					"oauth2_client_credentials": schema.SingleNestedAttribute{
+						Description: "Fetch access tokens with the OAuth2 client credentials flow. Tokens are refreshed before they expire.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"token_url": schema.StringAttribute{
+								Description: "URL of the token endpoint.",
+								Required:    true,
+							},
+							"client_id": schema.StringAttribute{
+								Description: "OAuth2 client identifier.",
+								Required:    true,
+							},
+							"client_secret": schema.StringAttribute{
+								Description: "OAuth2 client secret. Can also be set with the `LAVINMQ_API_OAUTH2_CLIENT_SECRET` environment variable.",
+								Optional:    true,
+								Sensitive:   true,
+							},
+							"scopes": schema.ListAttribute{
+								Description: "Scopes to request.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"endpoint_params": schema.MapAttribute{
+								Description: "Additional parameters sent to the token endpoint, e.g. `audience`.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded certificate authorities to trust when verifying the API server certificate, " +
+					"in addition to the system pool. Can also be set with the `LAVINMQ_API_CA_CERT_PEM` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file with certificate authorities to trust when verifying the API server certificate, " +
+					"in addition to the system pool. Can also be set with the `LAVINMQ_API_CA_CERT_FILE` environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate, or path to a file with it, presented to the API server. " +
+					"Requires `client_key`. Can also be set with the `LAVINMQ_API_CLIENT_CERT` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key for `client_cert`, or path to a file with it. " +
+					"Can also be set with the `LAVINMQ_API_CLIENT_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "Server name used to verify the API server certificate, if it differs from the host in `baseurl`. " +
+					"Can also be set with the `LAVINMQ_API_TLS_SERVER_NAME` environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the API server certificate. Only use this for testing. " +
+					"Can also be set with the `LAVINMQ_API_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP(S) or SOCKS5 proxy used for all requests to the API. " +
+					"Defaults to the proxy selected by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. " +
+					"Set to an empty string to connect directly.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional headers sent with every request to the API, e.g. for routing by a proxy. " +
+					"They are also sent to `proxy_url` when it tunnels HTTPS connections. " +
+					"Headers set by the provider itself, such as `Authorization`, cannot be overridden.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for a single request to the API, including reading the response. " +
+					"Requests that time out are retried according to `retry`. Set to `0s` to disable. Defaults to `1m`.",
+				Optional: true,
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Description: "Maximum number of requests per second sent to the API, shared by all resources and data sources. " +
+					"Short bursts up to the same number of requests are allowed. Unlimited when not set.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests in flight to the API at the same time, shared by all resources and data sources. " +
+					"Unlimited when not set.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"prevent_destroy_when_non_empty": schema.BoolAttribute{
+				Description: "Refuse to delete queues that contain messages, e.g. when a queue is destroyed or replaced. " +
+					"The apply fails with the number of messages in the queue instead. Defaults to `false`.",
+				Optional: true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry policy for failed requests to the API, e.g. during broker restarts.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: "Maximum number of attempts per request, including the first one. Set to 1 to disable retries. Defaults to 5.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						Description: "Backoff before the first retry, doubled for every following retry. Defaults to `500ms`.",
+						Optional:    true,
+					},
+					"max_backoff": schema.StringAttribute{
+						Description: "Maximum backoff between two attempts. Defaults to `30s`.",
+						Optional:    true,
+					},
+					"max_elapsed_time": schema.StringAttribute{
+						Description: "Maximum total time spent on a request including retries. Defaults to `2m`.",
+						Optional:    true,
+					},
+					"status_codes": schema.ListAttribute{
+						Description: "HTTP status codes that are retried. Defaults to `[429, 502, 503, 504]`.",
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -76,6 +283,11 @@ func (p *lavinmqProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	resp.Diagnostics.Append(applyProfile(&config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if config.BaseURL.IsNull() {
 		baseURL := os.Getenv("LAVINMQ_API_BASEURL")
 		if baseURL == "" {
@@ -98,16 +310,19 @@ func (p *lavinmqProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	// Username and password are only required for basic authentication.
+	basicAuth := config.Auth == nil && os.Getenv("LAVINMQ_API_TOKEN") == ""
+
 	if config.Username.IsNull() {
 		username := os.Getenv("LAVINMQ_API_USERNAME")
-		if username == "" {
+		if username == "" && basicAuth {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("username"),
 				"Missing LavinMQ API username",
 				"The provider cannot configure the lavinmq API client as there is a missing configuration "+
 					"value for the LavinMQ username.",
 			)
-		} else {
+		} else if username != "" {
 			config.Username = types.StringValue(username)
 		}
 	}
@@ -122,14 +337,14 @@ func (p *lavinmqProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	if config.Password.IsNull() {
 		password := os.Getenv("LAVINMQ_API_PASSWORD")
-		if password == "" {
+		if password == "" && basicAuth {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("password"),
 				"Missing LavinMQ API password",
 				"The provider cannot configure the lavinmq API client as there is a missing configuration "+
 					"value for the LavinMQ password.",
 			)
-		} else {
+		} else if password != "" {
 			config.Password = types.StringValue(password)
 		}
 	}
@@ -142,16 +357,78 @@ func (p *lavinmqProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	retryPolicy, diags := newRetryPolicy(ctx, config.Retry)
+	resp.Diagnostics.Append(diags...)
+
+	requestTimeout, diags := newRequestTimeout(config.RequestTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	tlsConfig, tlsConfigured, diags := newTLSConfig(&config)
+	resp.Diagnostics.Append(diags...)
+
+	var headers map[string]string
+	if !config.Headers.IsNull() && !config.Headers.IsUnknown() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpClient := p.httpClient
+	if tlsConfigured {
+		var err error
+		httpClient, err = clientlibrary.WithTLSConfig(p.httpClient, tlsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid TLS configuration", err.Error())
+			return
+		}
+	}
+	if !config.ProxyURL.IsNull() && !config.ProxyURL.IsUnknown() {
+		connectHeader := make(http.Header, len(headers))
+		for name, value := range headers {
+			connectHeader.Set(name, value)
+		}
+		var err error
+		httpClient, err = clientlibrary.WithProxy(httpClient, config.ProxyURL.ValueString(), connectHeader)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid proxy configuration", err.Error())
+			return
+		}
+	}
+
+	opts := []clientlibrary.ClientOption{
+		clientlibrary.WithRetryPolicy(retryPolicy),
+		clientlibrary.WithRequestTimeout(requestTimeout),
+	}
+	if len(headers) > 0 {
+		opts = append(opts, clientlibrary.WithHeaders(headers))
+	}
+	if rps := config.MaxRequestsPerSecond.ValueInt64(); rps > 0 {
+		opts = append(opts, clientlibrary.WithRateLimit(float64(rps), int(rps)))
+	}
+	if n := config.MaxConcurrentRequests.ValueInt64(); n > 0 {
+		opts = append(opts, clientlibrary.WithMaxConcurrentRequests(int(n)))
+	}
+	if config.PreventDestroyWhenNonEmpty.ValueBool() {
+		opts = append(opts, clientlibrary.WithNonEmptyQueueProtection())
+	}
+	authenticator, diags := newAuthenticator(ctx, &config, httpClient)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if authenticator != nil {
+		opts = append(opts, clientlibrary.WithAuthenticator(authenticator))
+	}
 
 	client := clientlibrary.NewClient(
 		config.BaseURL.ValueString(),
 		fmt.Sprintf("terraform-provider-lavinmq_%s", p.version),
 		config.Username.ValueString(),
 		config.Password.ValueString(),
-		p.httpClient,
+		httpClient,
+		opts...,
 	)
 	services := clientlibrary.NewServices(client)
 	resp.DataSourceData = services
@@ -163,11 +440,15 @@ func (p *lavinmqProvider) DataSources(_ context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewBindingsDataSource,
 		NewExchangesDataSource,
+		NewFederationLinksDataSource,
 		NewFederationUpstreamsDataSource,
+		NewOperatorPoliciesDataSource,
 		NewPermissionsDataSource,
 		NewPoliciesDataSource,
+		NewQueueDataSource,
 		NewQueuesDataSource,
 		NewShovelsDataSource,
+		NewTopicPermissionsDataSource,
 		NewUsersDataSource,
 		NewVhostDataSource,
 	}
@@ -179,13 +460,87 @@ func (p *lavinmqProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewBindingResource,
 		NewExchangeResource,
 		NewFederationUpstreamResource,
+		NewGlobalParameterResource,
+		NewOperatorPolicyResource,
+		NewParameterResource,
 		NewPermissionResource,
 		NewPolicyResource,
 		NewPublishMessageResource,
 		NewQueueActionResource,
 		NewQueueResource,
+		NewShovelActionResource,
 		NewShovelResource,
+		NewTopicPermissionResource,
+		NewUserLimitsResource,
 		NewUserResource,
 		NewVhostResource,
 	}
 }
+
+// newRequestTimeout parses the request timeout of the provider configuration.
+func newRequestTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return defaultRequestTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout < 0 {
+		diags.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid request timeout",
+			fmt.Sprintf("Expected a non-negative duration such as \"30s\" or \"1m\", got %q.", value.ValueString()),
+		)
+	}
+	return timeout, diags
+}
+
+// newRetryPolicy builds the client retry policy from the provider configuration,
+// falling back to the client library defaults for unset attributes.
+func newRetryPolicy(ctx context.Context, config *providerRetryModel) (clientlibrary.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := clientlibrary.DefaultRetryPolicy()
+	if config == nil {
+		return policy, diags
+	}
+
+	if !config.MaxAttempts.IsNull() {
+		policy.MaxAttempts = int(config.MaxAttempts.ValueInt64())
+	}
+
+	durations := []struct {
+		name  string
+		value types.String
+		field *time.Duration
+	}{
+		{"min_backoff", config.MinBackoff, &policy.MinBackoff},
+		{"max_backoff", config.MaxBackoff, &policy.MaxBackoff},
+		{"max_elapsed_time", config.MaxElapsedTime, &policy.MaxElapsedTime},
+	}
+	for _, d := range durations {
+		if d.value.IsNull() || d.value.IsUnknown() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil || duration < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(d.name),
+				"Invalid retry duration",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"30s\", got %q.", d.value.ValueString()),
+			)
+			continue
+		}
+		*d.field = duration
+	}
+
+	if !config.StatusCodes.IsNull() && !config.StatusCodes.IsUnknown() {
+		var statusCodes []int64
+		diags.Append(config.StatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		policy.StatusCodes = make([]int, 0, len(statusCodes))
+		for _, code := range statusCodes {
+			policy.StatusCodes = append(policy.StatusCodes, int(code))
+		}
+	}
+
+	return policy, diags
+}
@@ -0,0 +1,92 @@
+package lavinmq
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerAuthModel maps the auth block of the provider schema.
+type providerAuthModel struct {
+	BearerToken             types.String         `tfsdk:"bearer_token"`
+	OAuth2ClientCredentials *providerOAuth2Model `tfsdk:"oauth2_client_credentials"`
+}
+
+// providerOAuth2Model maps the OAuth2 client credentials block of the
+// provider schema.
+type providerOAuth2Model struct {
+	TokenURL       types.String `tfsdk:"token_url"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Scopes         types.List   `tfsdk:"scopes"`
+	EndpointParams types.Map    `tfsdk:"endpoint_params"`
+}
+
+// newAuthenticator returns the authenticator for the provider configuration,
+// or nil when basic authentication with username and password should be used.
+// The auth block takes precedence over username and password, which in turn
+// take precedence over the LAVINMQ_API_TOKEN environment variable.
+func newAuthenticator(ctx context.Context, config *lavinmqProviderModel, httpClient *http.Client) (clientlibrary.Authenticator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Auth == nil {
+		if config.Username.ValueString() != "" && config.Password.ValueString() != "" {
+			return nil, diags
+		}
+		if token := os.Getenv("LAVINMQ_API_TOKEN"); token != "" {
+			return clientlibrary.BearerToken{Token: token}, diags
+		}
+		return nil, diags
+	}
+
+	if oauth2 := config.Auth.OAuth2ClientCredentials; oauth2 != nil {
+		clientSecret := stringValueOrEnv(oauth2.ClientSecret, "LAVINMQ_API_OAUTH2_CLIENT_SECRET")
+		if clientSecret == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("oauth2_client_credentials").AtName("client_secret"),
+				"Missing OAuth2 client secret",
+				"The provider cannot configure the lavinmq API client as there is a missing configuration "+
+					"value for the OAuth2 client secret. Set client_secret or the LAVINMQ_API_OAUTH2_CLIENT_SECRET "+
+					"environment variable.",
+			)
+			return nil, diags
+		}
+
+		var scopes []string
+		if !oauth2.Scopes.IsNull() && !oauth2.Scopes.IsUnknown() {
+			diags.Append(oauth2.Scopes.ElementsAs(ctx, &scopes, false)...)
+		}
+		var endpointParams map[string]string
+		if !oauth2.EndpointParams.IsNull() && !oauth2.EndpointParams.IsUnknown() {
+			diags.Append(oauth2.EndpointParams.ElementsAs(ctx, &endpointParams, false)...)
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return clientlibrary.NewOAuth2ClientCredentials(clientlibrary.OAuth2ClientCredentialsConfig{
+			TokenURL:       oauth2.TokenURL.ValueString(),
+			ClientID:       oauth2.ClientID.ValueString(),
+			ClientSecret:   clientSecret,
+			Scopes:         scopes,
+			EndpointParams: endpointParams,
+		}, httpClient), diags
+	}
+
+	token := stringValueOrEnv(config.Auth.BearerToken, "LAVINMQ_API_TOKEN")
+	if token == "" {
+		diags.AddAttributeError(
+			path.Root("auth"),
+			"Missing LavinMQ API authentication",
+			"The auth block requires either bearer_token, the LAVINMQ_API_TOKEN environment variable "+
+				"or oauth2_client_credentials.",
+		)
+		return nil, diags
+	}
+	return clientlibrary.BearerToken{Token: token}, diags
+}
@@ -0,0 +1,102 @@
+package lavinmq
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("basic auth", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_TOKEN", "env-token")
+		auth, diags := newAuthenticator(ctx, &lavinmqProviderModel{
+			Username: types.StringValue("guest"),
+			Password: types.StringValue("guest"),
+		}, http.DefaultClient)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if auth != nil {
+			t.Errorf("authenticator = %#v, want nil for basic auth", auth)
+		}
+	})
+
+	t.Run("token environment variable", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_TOKEN", "env-token")
+		auth, diags := newAuthenticator(ctx, &lavinmqProviderModel{}, http.DefaultClient)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if auth != (clientlibrary.BearerToken{Token: "env-token"}) {
+			t.Errorf("authenticator = %#v, want bearer token from environment", auth)
+		}
+	})
+
+	t.Run("bearer token attribute", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_TOKEN", "env-token")
+		auth, diags := newAuthenticator(ctx, &lavinmqProviderModel{
+			Username: types.StringValue("guest"),
+			Password: types.StringValue("guest"),
+			Auth:     &providerAuthModel{BearerToken: types.StringValue("config-token")},
+		}, http.DefaultClient)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if auth != (clientlibrary.BearerToken{Token: "config-token"}) {
+			t.Errorf("authenticator = %#v, want bearer token from configuration", auth)
+		}
+	})
+
+	t.Run("empty auth block", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_TOKEN", "")
+		_, diags := newAuthenticator(ctx, &lavinmqProviderModel{
+			Auth: &providerAuthModel{BearerToken: types.StringNull()},
+		}, http.DefaultClient)
+		if !diags.HasError() {
+			t.Error("expected error diagnostic")
+		}
+	})
+
+	t.Run("oauth2 client credentials", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_OAUTH2_CLIENT_SECRET", "env-secret")
+		auth, diags := newAuthenticator(ctx, &lavinmqProviderModel{
+			Auth: &providerAuthModel{
+				BearerToken: types.StringNull(),
+				OAuth2ClientCredentials: &providerOAuth2Model{
+					TokenURL:       types.StringValue("https://idp.example.com/token"),
+					ClientID:       types.StringValue("terraform"),
+					ClientSecret:   types.StringNull(),
+					Scopes:         types.ListNull(types.StringType),
+					EndpointParams: types.MapNull(types.StringType),
+				},
+			},
+		}, http.DefaultClient)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if _, ok := auth.(*clientlibrary.OAuth2ClientCredentials); !ok {
+			t.Errorf("authenticator = %#v, want OAuth2 client credentials", auth)
+		}
+	})
+
+	t.Run("oauth2 client credentials without secret", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_OAUTH2_CLIENT_SECRET", "")
+		_, diags := newAuthenticator(ctx, &lavinmqProviderModel{
+			Auth: &providerAuthModel{
+				OAuth2ClientCredentials: &providerOAuth2Model{
+					TokenURL:     types.StringValue("https://idp.example.com/token"),
+					ClientID:     types.StringValue("terraform"),
+					ClientSecret: types.StringNull(),
+				},
+			},
+		}, http.DefaultClient)
+		if !diags.HasError() {
+			t.Error("expected error diagnostic")
+		}
+	})
+}
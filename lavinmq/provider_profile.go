@@ -0,0 +1,244 @@
+package lavinmq
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultCredentialsFile is the credentials file read when neither
+// credentials_file nor LAVINMQ_API_CREDENTIALS_FILE is set, relative to the
+// home directory of the user.
+const defaultCredentialsFile = ".lavinmq/credentials"
+
+// profileKeys are the settings a profile in the credentials file can hold,
+// named after the provider attributes they fill in.
+var profileKeys = []string{
+	"baseurl",
+	"username",
+	"password",
+	"bearer_token",
+	"ca_cert_pem",
+	"ca_cert_file",
+	"client_cert",
+	"client_key",
+	"tls_server_name",
+	"insecure_skip_verify",
+}
+
+// applyProfile fills in unset provider attributes from the selected profile
+// of the credentials file. The profile is selected with the profile attribute
+// or the LAVINMQ_API_PROFILE environment variable, without one the file is
+// not read.
+//
+// Attributes set in the configuration take precedence over the profile, and
+// the profile takes precedence over the other LAVINMQ_API_* environment
+// variables, so provider aliases using different profiles never pick up
+// settings meant for another cluster.
+func applyProfile(config *lavinmqProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.Profile.IsUnknown() || config.CredentialsFile.IsUnknown() {
+		diags.AddError(
+			"Unknown LavinMQ profile",
+			"The provider cannot configure the lavinmq API client as there is an unknown configuration "+
+				"value for the LavinMQ profile or credentials file.",
+		)
+		return diags
+	}
+
+	name := stringValueOrEnv(config.Profile, "LAVINMQ_API_PROFILE")
+	if name == "" {
+		return diags
+	}
+
+	file, err := credentialsFilePath(stringValueOrEnv(config.CredentialsFile, "LAVINMQ_API_CREDENTIALS_FILE"))
+	if err != nil {
+		diags.AddAttributeError(path.Root("credentials_file"), "Unable to locate credentials file", err.Error())
+		return diags
+	}
+	profiles, err := readCredentialsFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Missing LavinMQ profile",
+			fmt.Sprintf("The profile %q was selected, but the credentials file %s does not exist.", name, file),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("credentials_file"), "Unable to read credentials file", err.Error())
+		return diags
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Missing LavinMQ profile",
+			fmt.Sprintf("The profile %q does not exist in the credentials file %s. Available profiles: %s.",
+				name, file, strings.Join(names, ", ")),
+		)
+		return diags
+	}
+
+	for key := range profile {
+		if !slices.Contains(profileKeys, key) {
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Invalid LavinMQ profile",
+				fmt.Sprintf("Unknown setting %q in profile %q of %s, expected one of: %s.",
+					key, name, file, strings.Join(profileKeys, ", ")),
+			)
+		}
+	}
+
+	stringSettings := []struct {
+		key   string
+		value *types.String
+	}{
+		{"baseurl", &config.BaseURL},
+		{"username", &config.Username},
+		{"password", &config.Password},
+		{"client_cert", &config.ClientCert},
+		{"client_key", &config.ClientKey},
+		{"tls_server_name", &config.TLSServerName},
+	}
+	for _, s := range stringSettings {
+		if value, ok := profile[s.key]; ok && s.value.IsNull() {
+			*s.value = types.StringValue(value)
+		}
+	}
+
+	// The CA certificate attributes conflict with each other, so the profile
+	// only applies when neither is set in the configuration.
+	if config.CACertPEM.IsNull() && config.CACertFile.IsNull() {
+		if value, ok := profile["ca_cert_pem"]; ok {
+			config.CACertPEM = types.StringValue(value)
+		}
+		if value, ok := profile["ca_cert_file"]; ok {
+			config.CACertFile = types.StringValue(value)
+		}
+	}
+
+	if value, ok := profile["insecure_skip_verify"]; ok && config.InsecureSkipVerify.IsNull() {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Invalid LavinMQ profile",
+				fmt.Sprintf("Expected a boolean for insecure_skip_verify in profile %q, got %q.", name, value),
+			)
+		}
+		config.InsecureSkipVerify = types.BoolValue(insecure)
+	}
+
+	// A bearer token only applies when the configuration does not choose
+	// another way to authenticate.
+	if value, ok := profile["bearer_token"]; ok && config.Auth == nil && config.Username.IsNull() && config.Password.IsNull() {
+		config.Auth = &providerAuthModel{BearerToken: types.StringValue(value)}
+	}
+
+	return diags
+}
+
+// credentialsFilePath returns the path of the credentials file, expanding a
+// leading ~ to the home directory of the user.
+func credentialsFilePath(file string) (string, error) {
+	if file != "" && file != "~" && !strings.HasPrefix(file, "~/") {
+		return file, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if file == "" {
+		return filepath.Join(home, defaultCredentialsFile), nil
+	}
+	return filepath.Join(home, strings.TrimPrefix(file, "~")), nil
+}
+
+// readCredentialsFile parses the named profiles of a credentials file. Files
+// whose first setting is a [section] header are parsed as INI, other files as
+// YAML with one mapping per profile.
+func readCredentialsFile(file string) (map[string]map[string]string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]map[string]string
+	if isINI(content) {
+		profiles, err = parseINI(content)
+	} else {
+		err = yaml.Unmarshal(content, &profiles)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return profiles, nil
+}
+
+// isINI reports whether the first line that is not blank or a comment is an
+// INI section header.
+func isINI(content []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		return strings.HasPrefix(line, "[")
+	}
+	return false
+}
+
+// parseINI parses key = value settings grouped in [profile] sections. Lines
+// starting with # or ; are comments, and values can be wrapped in quotes.
+func parseINI(content []byte) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var section map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			section = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || section == nil {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value setting", lineNumber)
+		}
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		section[strings.TrimSpace(key)] = value
+	}
+	return profiles, scanner.Err()
+}
@@ -0,0 +1,186 @@
+package lavinmq
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsINI = `# LavinMQ clusters
+[staging]
+baseurl = https://staging.example.com
+username = terraform
+password = "s3cr=t"
+
+[production]
+baseurl = https://production.example.com
+bearer_token = production-token
+ca_cert_file = /etc/ssl/production-ca.pem
+insecure_skip_verify = false
+`
+
+const testCredentialsYAML = `staging:
+  baseurl: https://staging.example.com
+  username: terraform
+  password: s3cr=t
+production:
+  baseurl: https://production.example.com
+  bearer_token: production-token
+  ca_cert_file: /etc/ssl/production-ca.pem
+  insecure_skip_verify: false
+`
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestApplyProfile(t *testing.T) {
+	formats := map[string]string{"ini": testCredentialsINI, "yaml": testCredentialsYAML}
+	for format, content := range formats {
+		t.Run(format, func(t *testing.T) {
+			file := writeCredentialsFile(t, content)
+
+			staging := lavinmqProviderModel{Profile: types.StringValue("staging"), CredentialsFile: types.StringValue(file)}
+			if diags := applyProfile(&staging); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := staging.BaseURL.ValueString(); got != "https://staging.example.com" {
+				t.Errorf("baseurl = %q", got)
+			}
+			if got := staging.Username.ValueString(); got != "terraform" {
+				t.Errorf("username = %q", got)
+			}
+			if got := staging.Password.ValueString(); got != "s3cr=t" {
+				t.Errorf("password = %q", got)
+			}
+			if staging.Auth != nil {
+				t.Errorf("auth = %#v, want nil", staging.Auth)
+			}
+
+			production := lavinmqProviderModel{Profile: types.StringValue("production"), CredentialsFile: types.StringValue(file)}
+			if diags := applyProfile(&production); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if production.Auth == nil || production.Auth.BearerToken.ValueString() != "production-token" {
+				t.Errorf("auth = %#v, want bearer token from profile", production.Auth)
+			}
+			if got := production.CACertFile.ValueString(); got != "/etc/ssl/production-ca.pem" {
+				t.Errorf("ca_cert_file = %q", got)
+			}
+			if production.InsecureSkipVerify.IsNull() || production.InsecureSkipVerify.ValueBool() {
+				t.Errorf("insecure_skip_verify = %s, want false", production.InsecureSkipVerify)
+			}
+		})
+	}
+}
+
+func TestApplyProfilePrecedence(t *testing.T) {
+	file := writeCredentialsFile(t, testCredentialsINI)
+
+	t.Run("attributes take precedence over the profile", func(t *testing.T) {
+		config := lavinmqProviderModel{
+			Profile:         types.StringValue("staging"),
+			CredentialsFile: types.StringValue(file),
+			Username:        types.StringValue("admin"),
+		}
+		if diags := applyProfile(&config); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got := config.Username.ValueString(); got != "admin" {
+			t.Errorf("username = %q, want attribute value", got)
+		}
+		if got := config.Password.ValueString(); got != "s3cr=t" {
+			t.Errorf("password = %q, want profile value", got)
+		}
+	})
+
+	t.Run("profile takes precedence over environment variables", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_PROFILE", "staging")
+		t.Setenv("LAVINMQ_API_CREDENTIALS_FILE", file)
+		t.Setenv("LAVINMQ_API_TLS_SERVER_NAME", "lavinmq.internal")
+		config := lavinmqProviderModel{}
+		if diags := applyProfile(&config); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got := config.BaseURL.ValueString(); got != "https://staging.example.com" {
+			t.Errorf("baseurl = %q, want profile value", got)
+		}
+		// Settings missing from the profile still fall back to the environment.
+		if !config.TLSServerName.IsNull() {
+			t.Errorf("tls_server_name = %s, want null", config.TLSServerName)
+		}
+	})
+
+	t.Run("no profile selected", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_PROFILE", "")
+		t.Setenv("LAVINMQ_API_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+		config := lavinmqProviderModel{}
+		if diags := applyProfile(&config); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !config.BaseURL.IsNull() {
+			t.Errorf("baseurl = %s, want null", config.BaseURL)
+		}
+	})
+}
+
+func TestApplyProfileErrors(t *testing.T) {
+	file := writeCredentialsFile(t, testCredentialsINI)
+
+	tests := map[string]struct {
+		config lavinmqProviderModel
+		detail string
+	}{
+		"missing profile": {
+			config: lavinmqProviderModel{Profile: types.StringValue("development"), CredentialsFile: types.StringValue(file)},
+			detail: "Available profiles: production, staging.",
+		},
+		"missing file": {
+			config: lavinmqProviderModel{
+				Profile:         types.StringValue("staging"),
+				CredentialsFile: types.StringValue(filepath.Join(t.TempDir(), "missing")),
+			},
+			detail: "does not exist",
+		},
+		"unknown setting": {
+			config: lavinmqProviderModel{
+				Profile:         types.StringValue("staging"),
+				CredentialsFile: types.StringValue(writeCredentialsFile(t, "[staging]\nbase_url = https://staging.example.com\n")),
+			},
+			detail: `Unknown setting "base_url"`,
+		},
+		"invalid boolean": {
+			config: lavinmqProviderModel{
+				Profile:         types.StringValue("staging"),
+				CredentialsFile: types.StringValue(writeCredentialsFile(t, "[staging]\ninsecure_skip_verify = maybe\n")),
+			},
+			detail: "Expected a boolean",
+		},
+		"invalid INI": {
+			config: lavinmqProviderModel{
+				Profile:         types.StringValue("staging"),
+				CredentialsFile: types.StringValue(writeCredentialsFile(t, "[staging]\nbaseurl\n")),
+			},
+			detail: "line 2",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := applyProfile(&tt.config)
+			if !diags.HasError() {
+				t.Fatal("expected error diagnostic")
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tt.detail) {
+				t.Errorf("detail = %q, want it to contain %q", detail, tt.detail)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/vcr-testing/sanitizer"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -46,8 +47,17 @@ func TestMain(m *testing.M) {
 }
 
 func lavinMQResourceTest(t *testing.T, c resource.TestCase) {
+	lavinMQResourceTestWithServices(t, func(*clientlibrary.Services) resource.TestCase { return c })
+}
+
+// lavinMQResourceTestWithServices runs the test case returned by testCase. The
+// services passed to it send their requests through the same recorder as the
+// provider, so tests can change resources outside of Terraform.
+func lavinMQResourceTestWithServices(t *testing.T, testCase func(*clientlibrary.Services) resource.TestCase) {
+	testAccPreCheck(t)
+	cassetteName := fmt.Sprintf("../test/fixtures/vcr/%s", t.Name())
 	rec, err := recorder.NewWithOptions(&recorder.Options{
-		CassetteName:       fmt.Sprintf("../test/fixtures/vcr/%s", t.Name()),
+		CassetteName:       cassetteName,
 		Mode:               mode,
 		SkipRequestLatency: true,
 	})
@@ -61,9 +71,10 @@ func lavinMQResourceTest(t *testing.T, c resource.TestCase) {
 	}()
 
 	sanitizeHook := func(i *cassette.Interaction) error {
-		i.Request.Headers["Authorization"] = []string{"REDACTED"}
-		// Filter sensitive data API keys, secrets and tokens from request and response bodies
-		i.Request.Body = sanitizeSensitiveData(i.Request.Body)
+		i.Request.Headers["Authorization"] = []string{clientlibrary.Redacted}
+		// Filter sensitive data API keys, secrets and tokens from request and response bodies.
+		// Response bodies are replayed into state, so only request bodies are redacted further.
+		i.Request.Body = clientlibrary.RedactSecrets(sanitizeSensitiveData(i.Request.Body))
 		i.Response.Body = sanitizeSensitiveData(i.Response.Body)
 		return nil
 	}
@@ -87,6 +98,14 @@ func lavinMQResourceTest(t *testing.T, c resource.TestCase) {
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"lavinmq": providerserver.NewProtocol6WithError(New("vcr-test", rec.GetDefaultClient())),
 	}
+	services := clientlibrary.NewServices(clientlibrary.NewClient(
+		os.Getenv("LAVINMQ_API_BASEURL"),
+		"terraform-provider-lavinmq_vcr-test",
+		os.Getenv("LAVINMQ_API_USERNAME"),
+		os.Getenv("LAVINMQ_API_PASSWORD"),
+		rec.GetDefaultClient(),
+	))
+	c := testCase(services)
 	c.ProtoV6ProviderFactories = testAccProtoV6ProviderFactories
 
 	resource.Test(t, c)
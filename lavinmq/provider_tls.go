@@ -0,0 +1,114 @@
+package lavinmq
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTLSConfig builds the client TLS settings from the provider configuration
+// and the LAVINMQ_API_* environment variables. Attributes set in the
+// configuration take precedence over environment variables. The returned bool
+// reports whether any TLS setting was given, if not the injected HTTP client is
+// used as is.
+func newTLSConfig(config *lavinmqProviderModel) (clientlibrary.TLSConfig, bool, diag.Diagnostics) {
+	var (
+		diags     diag.Diagnostics
+		tlsConfig clientlibrary.TLSConfig
+		set       bool
+	)
+
+	caCertPEM, caCertFile := config.CACertPEM.ValueString(), config.CACertFile.ValueString()
+	if config.CACertPEM.IsNull() && config.CACertFile.IsNull() {
+		caCertPEM = os.Getenv("LAVINMQ_API_CA_CERT_PEM")
+		caCertFile = os.Getenv("LAVINMQ_API_CA_CERT_FILE")
+	}
+	switch {
+	case caCertPEM != "" && caCertFile != "":
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting CA certificate configuration",
+			"Only one of ca_cert_pem and ca_cert_file can be set, including their environment variables.",
+		)
+	case caCertPEM != "":
+		tlsConfig.CACertPEM = []byte(caCertPEM)
+		set = true
+	case caCertFile != "":
+		content, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Unable to read CA certificate file", err.Error())
+		}
+		tlsConfig.CACertPEM = content
+		set = true
+	}
+
+	pemAttributes := []struct {
+		name  string
+		value types.String
+		env   string
+		field *[]byte
+	}{
+		{"client_cert", config.ClientCert, "LAVINMQ_API_CLIENT_CERT", &tlsConfig.ClientCertPEM},
+		{"client_key", config.ClientKey, "LAVINMQ_API_CLIENT_KEY", &tlsConfig.ClientKeyPEM},
+	}
+	for _, a := range pemAttributes {
+		value := stringValueOrEnv(a.value, a.env)
+		if value == "" {
+			continue
+		}
+		content, err := readPEM(value)
+		if err != nil {
+			diags.AddAttributeError(path.Root(a.name), "Unable to read PEM file", err.Error())
+			continue
+		}
+		*a.field = content
+		set = true
+	}
+
+	if serverName := stringValueOrEnv(config.TLSServerName, "LAVINMQ_API_TLS_SERVER_NAME"); serverName != "" {
+		tlsConfig.ServerName = serverName
+		set = true
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsConfig.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+		set = true
+	} else if value := os.Getenv("LAVINMQ_API_INSECURE_SKIP_VERIFY"); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid LAVINMQ_API_INSECURE_SKIP_VERIFY",
+				fmt.Sprintf("Expected a boolean, got %q.", value),
+			)
+		}
+		tlsConfig.InsecureSkipVerify = insecure
+		set = true
+	}
+
+	return tlsConfig, set, diags
+}
+
+// stringValueOrEnv returns the attribute value, or the environment variable
+// when the attribute is not set.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// readPEM returns value when it is PEM encoded content, otherwise value is
+// treated as the path of a file with the content.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
@@ -0,0 +1,100 @@
+package lavinmq
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCACertPEM = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func TestNewTLSConfig(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(testCACertPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		_, set, diags := newTLSConfig(&lavinmqProviderModel{})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if set {
+			t.Error("expected TLS to not be configured")
+		}
+	})
+
+	t.Run("environment variables", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_CA_CERT_FILE", caFile)
+		t.Setenv("LAVINMQ_API_TLS_SERVER_NAME", "lavinmq.internal")
+		t.Setenv("LAVINMQ_API_INSECURE_SKIP_VERIFY", "true")
+
+		tlsConfig, set, diags := newTLSConfig(&lavinmqProviderModel{})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !set {
+			t.Fatal("expected TLS to be configured")
+		}
+		if string(tlsConfig.CACertPEM) != testCACertPEM {
+			t.Errorf("CACertPEM = %q, want %q", tlsConfig.CACertPEM, testCACertPEM)
+		}
+		if tlsConfig.ServerName != "lavinmq.internal" {
+			t.Errorf("ServerName = %q, want %q", tlsConfig.ServerName, "lavinmq.internal")
+		}
+		if !tlsConfig.InsecureSkipVerify {
+			t.Error("InsecureSkipVerify = false, want true")
+		}
+	})
+
+	t.Run("attributes take precedence over environment variables", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_CA_CERT_FILE", filepath.Join(t.TempDir(), "missing.pem"))
+		t.Setenv("LAVINMQ_API_TLS_SERVER_NAME", "lavinmq.internal")
+		t.Setenv("LAVINMQ_API_INSECURE_SKIP_VERIFY", "true")
+
+		tlsConfig, _, diags := newTLSConfig(&lavinmqProviderModel{
+			CACertPEM:          types.StringValue(testCACertPEM),
+			TLSServerName:      types.StringValue("lavinmq.example.com"),
+			InsecureSkipVerify: types.BoolValue(false),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if string(tlsConfig.CACertPEM) != testCACertPEM {
+			t.Errorf("CACertPEM = %q, want %q", tlsConfig.CACertPEM, testCACertPEM)
+		}
+		if tlsConfig.ServerName != "lavinmq.example.com" {
+			t.Errorf("ServerName = %q, want %q", tlsConfig.ServerName, "lavinmq.example.com")
+		}
+		if tlsConfig.InsecureSkipVerify {
+			t.Error("InsecureSkipVerify = true, want false")
+		}
+	})
+
+	t.Run("invalid insecure skip verify", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_INSECURE_SKIP_VERIFY", "maybe")
+		if _, _, diags := newTLSConfig(&lavinmqProviderModel{}); !diags.HasError() {
+			t.Error("expected error diagnostic")
+		}
+	})
+
+	t.Run("conflicting CA certificate environment variables", func(t *testing.T) {
+		t.Setenv("LAVINMQ_API_CA_CERT_PEM", testCACertPEM)
+		t.Setenv("LAVINMQ_API_CA_CERT_FILE", caFile)
+		if _, _, diags := newTLSConfig(&lavinmqProviderModel{}); !diags.HasError() {
+			t.Error("expected error diagnostic")
+		}
+	})
+
+	t.Run("missing client key file", func(t *testing.T) {
+		_, _, diags := newTLSConfig(&lavinmqProviderModel{
+			ClientCert: types.StringValue(testCACertPEM),
+			ClientKey:  types.StringValue(filepath.Join(t.TempDir(), "missing.key")),
+		})
+		if !diags.HasError() {
+			t.Error("expected error diagnostic")
+		}
+	})
+}
@@ -0,0 +1,318 @@
+package lavinmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// queueArgumentPolicyKeys maps the queue arguments that can be changed on an
+// existing queue to the policy definition keys that apply them.
+var queueArgumentPolicyKeys = map[string]string{
+	"x-message-ttl":             "message-ttl",
+	"x-max-length":              "max-length",
+	"x-dead-letter-exchange":    "dead-letter-exchange",
+	"x-dead-letter-routing-key": "dead-letter-routing-key",
+}
+
+// queueArgumentsPolicyPriority is the priority of the managed policy. Only the
+// policy with the highest priority applies to a queue.
+const queueArgumentsPolicyPriority = 100
+
+// declaredQueueArgumentsKey is the private state key holding the arguments
+// the queue was declared with, which the managed policy cannot change.
+const declaredQueueArgumentsKey = "declared_arguments"
+
+// privateState is implemented by the private state of plan modifier requests
+// and resource responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func queueArgumentsPolicyName(queue string) string {
+	return "terraform-queue-arguments-" + queue
+}
+
+// queueArgumentAttributes returns the attributes of the arguments object, or
+// nil when the arguments are null, unknown or not an object.
+func queueArgumentAttributes(arguments types.Dynamic) map[string]attr.Value {
+	if arguments.IsNull() || arguments.IsUnknown() {
+		return nil
+	}
+	object, ok := arguments.UnderlyingValue().(types.Object)
+	if !ok {
+		return nil
+	}
+	return object.Attributes()
+}
+
+// changedQueueArguments returns the sorted names of the arguments that are
+// added, changed or removed between state and plan.
+func changedQueueArguments(state, plan map[string]attr.Value) []string {
+	var changed []string
+	for key, value := range plan {
+		if stateValue, ok := state[key]; !ok || !stateValue.Equal(value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range state {
+		if _, ok := plan[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// queueArgumentPolicyApplies reports whether the new value of an argument, nil
+// when it is removed, takes effect through the managed policy. The broker
+// keeps the declared arguments of a queue over a policy, except for a message
+// TTL or max length that the policy lowers.
+func queueArgumentPolicyApplies(key string, value attr.Value, declared map[string]any) bool {
+	if _, ok := queueArgumentPolicyKeys[key]; !ok {
+		return false
+	}
+	declaredValue, ok := declared[key]
+	if !ok {
+		return value == nil || !value.IsUnknown()
+	}
+	if key != "x-message-ttl" && key != "x-max-length" {
+		return false
+	}
+	number, ok := value.(types.Number)
+	declaredNumber, declaredOk := declaredValue.(float64)
+	if !ok || !declaredOk || number.IsNull() || number.IsUnknown() {
+		return false
+	}
+	return number.ValueBigFloat().Cmp(big.NewFloat(declaredNumber)) < 0
+}
+
+// setDeclaredQueueArguments stores the declared arguments of the queue in
+// private state for the arguments plan modifier.
+func setDeclaredQueueArguments(ctx context.Context, private privateState, arguments map[string]any) diag.Diagnostics {
+	if arguments == nil {
+		arguments = map[string]any{}
+	}
+	value, err := json.Marshal(arguments)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to encode queue arguments", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, declaredQueueArgumentsKey, value)
+}
+
+// declaredQueueArguments returns the declared arguments from private state.
+// State written by older provider versions has none, so the arguments in
+// state are used instead, which never lets the policy remove an argument.
+func declaredQueueArguments(ctx context.Context, private privateState, state types.Dynamic) (map[string]any, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, declaredQueueArgumentsKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if value == nil {
+		arguments, err := converters.DynamicToMap(state)
+		if err != nil {
+			diags.AddError("Failed to convert queue arguments", err.Error())
+			return nil, diags
+		}
+		// Decode the arguments like the private state, with float64 numbers.
+		value, err = json.Marshal(arguments)
+		if err != nil {
+			diags.AddError("Failed to encode queue arguments", err.Error())
+			return nil, diags
+		}
+	}
+
+	var declared map[string]any
+	if err := json.Unmarshal(value, &declared); err != nil {
+		diags.AddError("Failed to decode queue arguments", err.Error())
+	}
+	return declared, diags
+}
+
+// effectiveQueueArguments returns the declared arguments of the queue with
+// the arguments applied by the managed policy. LavinMQ applies only one policy
+// per queue, so a warning is added when another policy is applied instead.
+func (r *queueResource) effectiveQueueArguments(ctx context.Context, state queueResourceModel, queue *clientlibrary.QueueResponse) (map[string]any, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	policyName := queueArgumentsPolicyName(state.Name.ValueString())
+	policy, err := r.services.Policies.Get(ctx, state.Vhost.ValueString(), policyName)
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		return queue.Arguments, diags, nil
+	}
+	if err != nil {
+		return nil, diags, err
+	}
+
+	if queue.Policy != policyName {
+		applied := "no policy"
+		if queue.Policy != "" {
+			applied = fmt.Sprintf("policy %q", queue.Policy)
+		}
+		diags.AddAttributeWarning(path.Root("arguments"), "Queue arguments policy not applied",
+			fmt.Sprintf("Queue %q has %s applied instead of the managed policy %q, so the arguments set through it "+
+				"are not in effect. LavinMQ applies only one policy per queue, the one with the highest priority. "+
+				"Lower the priority of other policies matching the queue below %d.",
+				state.Name.ValueString(), applied, policyName, queueArgumentsPolicyPriority))
+	}
+
+	arguments := make(map[string]any, len(queue.Arguments))
+	for key, value := range queue.Arguments {
+		arguments[key] = value
+	}
+	for key, policyKey := range queueArgumentPolicyKeys {
+		if value, ok := policy.Definition[policyKey]; ok {
+			arguments[key] = value
+		}
+	}
+	return arguments, diags, nil
+}
+
+// updateArgumentsPolicy writes the arguments that differ from the declared
+// arguments of the queue to the managed policy, and deletes the policy when
+// there are none or when arguments_update_mode is no longer 'policy'.
+func (r *queueResource) updateArgumentsPolicy(ctx context.Context, plan, state queueResourceModel) error {
+	vhost, name := plan.Vhost.ValueString(), plan.Name.ValueString()
+	policyName := queueArgumentsPolicyName(name)
+
+	if plan.ArgumentsUpdateMode.ValueString() != "policy" {
+		if state.ArgumentsUpdateMode.ValueString() != "policy" {
+			return nil
+		}
+		return r.services.Policies.Delete(ctx, vhost, policyName)
+	}
+
+	queue, err := r.services.Queues.Get(ctx, vhost, name)
+	if err != nil {
+		return err
+	}
+
+	arguments, err := converters.DynamicToMap(plan.Arguments)
+	if err != nil {
+		return err
+	}
+	definition := make(map[string]any)
+	for key, value := range arguments {
+		policyKey, ok := queueArgumentPolicyKeys[key]
+		if !ok {
+			continue
+		}
+		if declared, ok := queue.Arguments[key]; ok && sameJSON(declared, value) {
+			continue
+		}
+		definition[policyKey] = value
+	}
+	if len(definition) == 0 {
+		return r.services.Policies.Delete(ctx, vhost, policyName)
+	}
+
+	return r.services.Policies.CreateOrUpdate(ctx, vhost, policyName, clientlibrary.PolicyRequest{
+		Pattern:    "^" + regexp.QuoteMeta(name) + "$",
+		Definition: definition,
+		Priority:   queueArgumentsPolicyPriority,
+		ApplyTo:    "queues",
+	})
+}
+
+// sameJSON reports whether two values encode to the same JSON, which treats
+// numbers decoded by the API and numbers from the plan alike.
+func sameJSON(a, b any) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+func queueArgumentsPlanModifier() planmodifier.Dynamic {
+	return &queueArgumentsModifier{}
+}
+
+// queueArgumentsModifier replaces the queue when its arguments change, since
+// queue arguments are immutable, unless arguments_update_mode is 'policy' and
+// every change takes effect through the managed policy. The path taken is
+// shown as a warning in the plan.
+type queueArgumentsModifier struct{}
+
+func (m *queueArgumentsModifier) Description(ctx context.Context) string {
+	return "If the queue arguments change, Terraform will destroy and recreate the queue, unless the change can be applied through a policy."
+}
+
+func (m *queueArgumentsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *queueArgumentsModifier) PlanModifyDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	var name, mode types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("arguments_update_mode"), &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateArguments := queueArgumentAttributes(req.StateValue)
+	planArguments := queueArgumentAttributes(req.PlanValue)
+	var changed []string
+	if req.PlanValue.IsUnknown() {
+		changed = []string{"(known after apply)"}
+	} else {
+		changed = changedQueueArguments(stateArguments, planArguments)
+	}
+
+	if mode.ValueString() != "policy" {
+		resp.RequiresReplace = true
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Queue will be replaced",
+			fmt.Sprintf("The arguments %s of queue %q changed. Queue arguments are immutable, so the queue is deleted "+
+				"and declared again and its messages are lost. Set arguments_update_mode = \"policy\" to apply "+
+				"x-message-ttl, x-max-length and dead letter arguments through a policy instead.",
+				strings.Join(changed, ", "), name.ValueString()))
+		return
+	}
+
+	declared, diags := declaredQueueArguments(ctx, req.Private, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var immutable []string
+	for _, key := range changed {
+		if req.PlanValue.IsUnknown() || !queueArgumentPolicyApplies(key, planArguments[key], declared) {
+			immutable = append(immutable, key)
+		}
+	}
+
+	if len(immutable) > 0 {
+		resp.RequiresReplace = true
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Queue will be replaced",
+			fmt.Sprintf("The arguments %s of queue %q cannot be applied through a policy. Queue arguments are immutable, "+
+				"so the queue is deleted and declared again and its messages are lost.",
+				strings.Join(immutable, ", "), name.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(req.Path, "Queue arguments applied through a policy",
+		fmt.Sprintf("The arguments %s of queue %q are applied in place through the provider managed policy %q.",
+			strings.Join(changed, ", "), name.ValueString(), queueArgumentsPolicyName(name.ValueString())))
+}
@@ -0,0 +1,90 @@
+package lavinmq
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQueueArgumentPolicyApplies(t *testing.T) {
+	declared := map[string]any{
+		"x-message-ttl":          float64(60000),
+		"x-dead-letter-exchange": "dlx",
+		"x-max-priority":         float64(5),
+	}
+	number := func(v int64) attr.Value { return types.NumberValue(new(big.Float).SetInt64(v)) }
+
+	tests := []struct {
+		name  string
+		key   string
+		value attr.Value
+		want  bool
+	}{
+		{"lowered ttl", "x-message-ttl", number(30000), true},
+		{"raised ttl", "x-message-ttl", number(90000), false},
+		{"removed declared ttl", "x-message-ttl", nil, false},
+		{"added max length", "x-max-length", number(100), true},
+		{"removed policy max length", "x-max-length", nil, true},
+		{"unknown max length", "x-max-length", types.NumberUnknown(), false},
+		{"changed declared dead letter exchange", "x-dead-letter-exchange", types.StringValue("other"), false},
+		{"added dead letter routing key", "x-dead-letter-routing-key", types.StringValue("dead"), true},
+		{"changed max priority", "x-max-priority", number(10), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := queueArgumentPolicyApplies(tt.key, tt.value, declared); got != tt.want {
+				t.Errorf("queueArgumentPolicyApplies(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveQueueArgumentsPolicyNotApplied(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"terraform-queue-arguments-queue","vhost":"/","pattern":"^queue$",` +
+			`"apply-to":"queues","priority":100,"definition":{"message-ttl":1000}}`))
+	}))
+	defer server.Close()
+
+	r := &queueResource{services: clientlibrary.NewServices(clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client()))}
+	state := queueResourceModel{Vhost: types.StringValue("/"), Name: types.StringValue("queue")}
+
+	tests := []struct {
+		name    string
+		policy  string
+		warning string
+	}{
+		{"managed policy", "terraform-queue-arguments-queue", ""},
+		{"other policy", "user-policy", `has policy "user-policy" applied`},
+		{"no policy", "", "has no policy applied"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &clientlibrary.QueueResponse{Policy: tt.policy, Arguments: map[string]any{"x-max-length": float64(10)}}
+			arguments, diags, err := r.effectiveQueueArguments(context.Background(), state, queue)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(arguments["x-message-ttl"]) != "1000" || fmt.Sprint(arguments["x-max-length"]) != "10" {
+				t.Errorf("unexpected arguments %v", arguments)
+			}
+			if tt.warning == "" {
+				if len(diags) != 0 {
+					t.Errorf("unexpected diagnostics %v", diags)
+				}
+				return
+			}
+			if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), tt.warning) {
+				t.Errorf("diagnostics %v, want warning containing %q", diags, tt.warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package lavinmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The queue arguments managed by the queue_type, max_age, max_length_bytes and
+// stream_max_segment_size_bytes attributes.
+const (
+	queueTypeArgument                 = "x-queue-type"
+	maxAgeArgument                    = "x-max-age"
+	maxLengthBytesArgument            = "x-max-length-bytes"
+	streamMaxSegmentSizeBytesArgument = "x-stream-max-segment-size-bytes"
+)
+
+// queueTypedArguments maps the queue arguments with their own attribute to
+// the name of the attribute.
+var queueTypedArguments = map[string]string{
+	queueTypeArgument:                 "queue_type",
+	maxAgeArgument:                    "max_age",
+	maxLengthBytesArgument:            "max_length_bytes",
+	streamMaxSegmentSizeBytesArgument: "stream_max_segment_size_bytes",
+}
+
+// maxAgeRegexp matches the retention periods accepted by x-max-age, a number
+// followed by Y, M, D, h, m or s.
+var maxAgeRegexp = regexp.MustCompile(`^[1-9][0-9]*[YMDhms]$`)
+
+// typedQueueArguments returns the queue arguments of the typed attributes of
+// the plan. A queue without queue_type is declared without x-queue-type and
+// gets the default queue type of the vhost.
+func typedQueueArguments(plan queueResourceModel) map[string]any {
+	arguments := make(map[string]any)
+	if !plan.QueueType.IsNull() && !plan.QueueType.IsUnknown() {
+		arguments[queueTypeArgument] = plan.QueueType.ValueString()
+	}
+	if !plan.MaxAge.IsNull() && !plan.MaxAge.IsUnknown() {
+		arguments[maxAgeArgument] = plan.MaxAge.ValueString()
+	}
+	if !plan.MaxLengthBytes.IsNull() && !plan.MaxLengthBytes.IsUnknown() {
+		arguments[maxLengthBytesArgument] = plan.MaxLengthBytes.ValueInt64()
+	}
+	if !plan.StreamMaxSegmentSizeBytes.IsNull() && !plan.StreamMaxSegmentSizeBytes.IsUnknown() {
+		arguments[streamMaxSegmentSizeBytesArgument] = plan.StreamMaxSegmentSizeBytes.ValueInt64()
+	}
+	return arguments
+}
+
+// queueTypeValue returns the type of a queue declared with the arguments. The
+// API does not report the type of a queue declared without x-queue-type, it
+// depends on the default queue type of the vhost, so the value is null then.
+func queueTypeValue(arguments map[string]any) types.String {
+	if queueType, ok := arguments[queueTypeArgument].(string); ok && queueType != "" {
+		return types.StringValue(queueType)
+	}
+	return types.StringNull()
+}
+
+// setTypedQueueArguments sets the typed attributes of the state from the
+// arguments returned by the API, and returns the arguments that belong in the
+// arguments attribute. Arguments already in the arguments attribute stay
+// there, so configurations that set them through arguments do not drift.
+func setTypedQueueArguments(state *queueResourceModel, arguments map[string]any) map[string]any {
+	managed := queueArgumentAttributes(state.Arguments)
+	remaining := make(map[string]any, len(arguments))
+	for key, value := range arguments {
+		if _, typed := queueTypedArguments[key]; !typed {
+			remaining[key] = value
+			continue
+		}
+		if _, ok := managed[key]; ok {
+			remaining[key] = value
+		}
+	}
+
+	state.QueueType = queueTypeValue(arguments)
+	if _, ok := managed[maxAgeArgument]; !ok {
+		state.MaxAge = types.StringNull()
+		if maxAge, ok := arguments[maxAgeArgument].(string); ok {
+			state.MaxAge = types.StringValue(maxAge)
+		}
+	}
+	if _, ok := managed[maxLengthBytesArgument]; !ok {
+		state.MaxLengthBytes = int64ArgumentValue(arguments[maxLengthBytesArgument])
+	}
+	if _, ok := managed[streamMaxSegmentSizeBytesArgument]; !ok {
+		state.StreamMaxSegmentSizeBytes = int64ArgumentValue(arguments[streamMaxSegmentSizeBytesArgument])
+	}
+	return remaining
+}
+
+// int64ArgumentValue converts an integer argument returned by the API into an
+// Int64 value, which is null when the argument is missing or not an integer.
+func int64ArgumentValue(value any) types.Int64 {
+	switch v := value.(type) {
+	case int64:
+		return types.Int64Value(v)
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return types.Int64Value(int64(v))
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+// streamQueueValidator validates that attributes that only apply to streams
+// are set on a stream.
+type streamQueueValidator struct{}
+
+func (v streamQueueValidator) Description(_ context.Context) string {
+	return "only valid when queue_type is 'stream'"
+}
+
+func (v streamQueueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v streamQueueValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validateStreamQueue(ctx, req.Config, req.Path)...)
+}
+
+func (v streamQueueValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validateStreamQueue(ctx, req.Config, req.Path)...)
+}
+
+// validateStreamQueue returns an error when the queue is not configured as a
+// stream. A queue_type that is not set is allowed when x-queue-type is set
+// through arguments instead.
+func validateStreamQueue(ctx context.Context, config tfsdk.Config, attributePath path.Path) diag.Diagnostics {
+	var queueType types.String
+	var arguments types.Dynamic
+	diags := config.GetAttribute(ctx, path.Root("queue_type"), &queueType)
+	diags.Append(config.GetAttribute(ctx, path.Root("arguments"), &arguments)...)
+	if diags.HasError() || queueType.IsUnknown() || arguments.IsUnknown() {
+		return diags
+	}
+
+	if queueType.IsNull() {
+		if value, ok := queueArgumentAttributes(arguments)[queueTypeArgument].(types.String); ok && (value.IsUnknown() || value.ValueString() == "stream") {
+			return diags
+		}
+	} else if queueType.ValueString() == "stream" {
+		return diags
+	}
+
+	diags.AddAttributeError(attributePath, "Invalid stream attribute",
+		fmt.Sprintf("Attribute %s only applies to streams, set queue_type = \"stream\".", attributePath))
+	return diags
+}
+
+// queueTypeValidator validates that streams are durable and not auto deleted.
+type queueTypeValidator struct{}
+
+func (v queueTypeValidator) Description(_ context.Context) string {
+	return "streams must be durable and must not be auto deleted"
+}
+
+func (v queueTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queueTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.ValueString() != "stream" {
+		return
+	}
+
+	var durable, autoDelete types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("durable"), &durable)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_delete"), &autoDelete)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !durable.IsNull() && !durable.IsUnknown() && !durable.ValueBool() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid stream queue", "Streams must be durable, set durable = true.")
+	}
+	if autoDelete.ValueBool() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid stream queue", "Streams cannot be auto deleted, set auto_delete = false.")
+	}
+}
+
+// queueArgumentsConflictValidator rejects arguments that are also set through
+// their typed attribute.
+type queueArgumentsConflictValidator struct{}
+
+func (v queueArgumentsConflictValidator) Description(_ context.Context) string {
+	return "arguments must not set x-queue-type, x-max-age, x-max-length-bytes or x-stream-max-segment-size-bytes when their attribute is set"
+}
+
+func (v queueArgumentsConflictValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queueArgumentsConflictValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	for key := range queueArgumentAttributes(req.ConfigValue) {
+		name, ok := queueTypedArguments[key]
+		if !ok {
+			continue
+		}
+		var value attr.Value
+		if name == "queue_type" || name == "max_age" {
+			var stringValue types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &stringValue)...)
+			value = stringValue
+		} else {
+			var int64Value types.Int64
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &int64Value)...)
+			value = int64Value
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path, "Conflicting queue arguments",
+				fmt.Sprintf("Argument %q conflicts with attribute %s, set only one of them.", key, name))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package lavinmq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetTypedQueueArguments(t *testing.T) {
+	arguments := map[string]any{
+		"x-queue-type":                    "stream",
+		"x-max-age":                       "7D",
+		"x-max-length-bytes":              float64(1000000000),
+		"x-stream-max-segment-size-bytes": float64(50000000),
+		"x-message-ttl":                   float64(60000),
+	}
+
+	t.Run("typed attributes", func(t *testing.T) {
+		state := queueResourceModel{Arguments: types.DynamicNull()}
+		remaining := setTypedQueueArguments(&state, arguments)
+
+		if len(remaining) != 1 || remaining["x-message-ttl"] != float64(60000) {
+			t.Errorf("remaining = %v, want only x-message-ttl", remaining)
+		}
+		if got := state.QueueType.ValueString(); got != "stream" {
+			t.Errorf("queue_type = %q, want stream", got)
+		}
+		if got := state.MaxAge.ValueString(); got != "7D" {
+			t.Errorf("max_age = %q, want 7D", got)
+		}
+		if got := state.MaxLengthBytes.ValueInt64(); got != 1000000000 {
+			t.Errorf("max_length_bytes = %d, want 1000000000", got)
+		}
+		if got := state.StreamMaxSegmentSizeBytes.ValueInt64(); got != 50000000 {
+			t.Errorf("stream_max_segment_size_bytes = %d, want 50000000", got)
+		}
+	})
+
+	t.Run("arguments in state stay in arguments", func(t *testing.T) {
+		object := types.ObjectValueMust(
+			map[string]attr.Type{"x-queue-type": types.StringType, "x-max-age": types.StringType},
+			map[string]attr.Value{"x-queue-type": types.StringValue("stream"), "x-max-age": types.StringValue("7D")},
+		)
+		state := queueResourceModel{Arguments: types.DynamicValue(object)}
+		remaining := setTypedQueueArguments(&state, arguments)
+
+		for _, key := range []string{"x-queue-type", "x-max-age", "x-message-ttl"} {
+			if _, ok := remaining[key]; !ok {
+				t.Errorf("remaining is missing %s", key)
+			}
+		}
+		if _, ok := remaining["x-max-length-bytes"]; ok {
+			t.Errorf("remaining contains x-max-length-bytes")
+		}
+		if got := state.QueueType.ValueString(); got != "stream" {
+			t.Errorf("queue_type = %q, want stream", got)
+		}
+		if !state.MaxAge.IsNull() {
+			t.Errorf("max_age = %s, want null", state.MaxAge)
+		}
+	})
+
+	t.Run("queue without type", func(t *testing.T) {
+		state := queueResourceModel{
+			Arguments:      types.DynamicNull(),
+			MaxAge:         types.StringValue("7D"),
+			MaxLengthBytes: types.Int64Value(100),
+		}
+		setTypedQueueArguments(&state, map[string]any{})
+
+		if !state.QueueType.IsNull() {
+			t.Errorf("queue_type = %s, want null", state.QueueType)
+		}
+		if !state.MaxAge.IsNull() || !state.MaxLengthBytes.IsNull() {
+			t.Errorf("retention attributes not null after removal: %s, %s", state.MaxAge, state.MaxLengthBytes)
+		}
+	})
+}
+
+func TestTypedQueueArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		queueType types.String
+		want      any
+	}{
+		{"stream", types.StringValue("stream"), "stream"},
+		{"classic", types.StringValue("classic"), "classic"},
+		{"vhost default", types.StringNull(), nil},
+		{"unknown", types.StringUnknown(), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := queueResourceModel{QueueType: tt.queueType}
+			if got := typedQueueArguments(plan)[queueTypeArgument]; got != tt.want {
+				t.Errorf("x-queue-type = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt64ArgumentValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  types.Int64
+	}{
+		{"float", float64(1048576), types.Int64Value(1048576)},
+		{"int", int64(42), types.Int64Value(42)},
+		{"json number", json.Number("9007199254740993"), types.Int64Value(9007199254740993)},
+		{"fraction", 1.5, types.Int64Null()},
+		{"string", "100", types.Int64Null()},
+		{"missing", nil, types.Int64Null()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := int64ArgumentValue(tt.value); !got.Equal(tt.want) {
+				t.Errorf("int64ArgumentValue(%v) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,12 +2,13 @@ package lavinmq
 
 import (
 	"context"
-	"math/big"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,20 +34,21 @@ type bindingResource struct {
 }
 
 type bindingResourceModel struct {
-	Vhost           types.String  `tfsdk:"vhost"`
-	Source          types.String  `tfsdk:"source"`
-	Destination     types.String  `tfsdk:"destination"`
-	DestinationType types.String  `tfsdk:"destination_type"`
-	RoutingKey      types.String  `tfsdk:"routing_key"`
-	Arguments       types.Dynamic `tfsdk:"arguments"`
-	PropertiesKey   types.String  `tfsdk:"properties_key"`
+	Vhost           types.String   `tfsdk:"vhost"`
+	Source          types.String   `tfsdk:"source"`
+	Destination     types.String   `tfsdk:"destination"`
+	DestinationType types.String   `tfsdk:"destination_type"`
+	RoutingKey      types.String   `tfsdk:"routing_key"`
+	Arguments       types.Dynamic  `tfsdk:"arguments"`
+	PropertiesKey   types.String   `tfsdk:"properties_key"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_binding"
 }
 
-func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a binding between an exchange and a queue or exchange.",
 		Attributes: map[string]schema.Attribute{
@@ -104,6 +106,9 @@ func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -140,36 +145,27 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var request clientlibrary.BindingRequest
 	request.RoutingKey = plan.RoutingKey.ValueString()
 
-	argumentsMap := make(map[string]any)
-	if !plan.Arguments.IsNull() && !plan.Arguments.IsUnknown() {
-		switch v := plan.Arguments.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					argumentsMap[key] = val.ValueString()
-				case types.Bool:
-					argumentsMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							argumentsMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							argumentsMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	argumentsMap, err := converters.DynamicToMap(plan.Arguments)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("arguments"), "Invalid binding arguments", err.Error())
+		return
 	}
 	if len(argumentsMap) > 0 {
 		request.Arguments = argumentsMap
 	}
 
-	err := r.services.Bindings.Create(
+	err = r.services.Bindings.Create(
 		ctx,
 		plan.Vhost.ValueString(),
 		plan.Source.ValueString(),
@@ -178,13 +174,13 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		request,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating binding", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating binding", err)
 		return
 	}
 
 	bindings, err := r.services.Bindings.List(ctx, plan.Vhost.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading binding", err.Error())
+		addAPIError(&resp.Diagnostics, "Error reading binding", err)
 		return
 	}
 
@@ -219,6 +215,14 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	binding, err := r.services.Bindings.Get(
 		ctx,
 		state.Vhost.ValueString(),
@@ -227,11 +231,7 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		state.DestinationType.ValueString(),
 		state.PropertiesKey.ValueString(),
 	)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading binding", err.Error())
-		return
-	}
-	if binding == nil {
+	if errors.Is(err, clientlibrary.ErrNotFound) {
 		tflog.Info(ctx, "Binding not found on server, removing from state", map[string]any{
 			"vhost":       state.Vhost.ValueString(),
 			"source":      state.Source.ValueString(),
@@ -240,35 +240,19 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading binding", err)
+		return
+	}
 
 	state.RoutingKey = types.StringValue(binding.RoutingKey)
 
 	if len(binding.Arguments) > 0 {
-		attributes := make(map[string]attr.Value)
-		for key, value := range binding.Arguments {
-			switch v := value.(type) {
-			case int64:
-				attributes[key] = types.NumberValue(new(big.Float).SetInt64(v))
-			case float64:
-				attributes[key] = types.NumberValue(new(big.Float).SetFloat64(v))
-			case bool:
-				attributes[key] = types.BoolValue(v)
-			case string:
-				attributes[key] = types.StringValue(v)
-			}
-		}
-
-		attributeTypes := make(map[string]attr.Type)
-		for key := range attributes {
-			attributeTypes[key] = attributes[key].Type(ctx)
-		}
-
-		argumentsObject, diags := types.ObjectValue(attributeTypes, attributes)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
+		state.Arguments, diags = converters.MapToDynamic(ctx, binding.Arguments)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		state.Arguments = types.DynamicValue(argumentsObject)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -278,10 +262,9 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Bindings cannot be updated. They must be replaced.",
-	)
+	// Bindings cannot be updated and are replaced instead, only the timeouts
+	// block can change in place.
+	updateTimeouts(ctx, req, resp)
 }
 
 func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -291,6 +274,14 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Bindings.Delete(
 		ctx,
 		state.Vhost.ValueString(),
@@ -300,7 +291,7 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		state.PropertiesKey.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting binding", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting binding", err)
 		return
 	}
 
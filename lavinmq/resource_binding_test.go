@@ -155,6 +155,61 @@ func TestAccBinding_HeadersExchange(t *testing.T) {
 	})
 }
 
+func TestAccBinding_NestedArguments(t *testing.T) {
+	t.Parallel()
+	bindingResourceName := "lavinmq_binding.test_binding"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_exchange" "test_exchange" {
+            name        = "vcr_test_nested_args_exchange"
+            vhost       = "/"
+            type        = "headers"
+            durable     = true
+            auto_delete = false
+          }
+
+          resource "lavinmq_queue" "test_queue" {
+            name        = "vcr_test_queue_nested_args"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+          }
+
+          resource "lavinmq_binding" "test_binding" {
+            vhost            = "/"
+            source           = lavinmq_exchange.test_exchange.name
+            destination      = lavinmq_queue.test_queue.name
+            destination_type = "queue"
+            routing_key      = ""
+            arguments = {
+              x-match = "any"
+              formats = ["pdf", "zip"]
+              meta = {
+                team = "ops"
+              }
+              ratio = 0.1
+              size  = 9007199254740993
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.x-match", "any"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.formats.#", "2"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.formats.0", "pdf"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.formats.1", "zip"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.meta.team", "ops"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.ratio", "0.1"),
+					resource.TestCheckResourceAttr(bindingResourceName, "arguments.size", "9007199254740993"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBinding_TopicExchange(t *testing.T) {
 	t.Parallel()
 	bindingResourceName := "lavinmq_binding.test_binding"
@@ -2,13 +2,14 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/big"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -39,13 +40,14 @@ type exchangeResource struct {
 
 // exchangeResourceModel is the
 type exchangeResourceModel struct {
-	ID         types.String  `tfsdk:"id"`
-	Name       types.String  `tfsdk:"name"`
-	Vhost      types.String  `tfsdk:"vhost"`
-	Type       types.String  `tfsdk:"type"`
-	AutoDelete types.Bool    `tfsdk:"auto_delete"`
-	Durable    types.Bool    `tfsdk:"durable"`
-	Arguments  types.Dynamic `tfsdk:"arguments"`
+	ID         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Vhost      types.String   `tfsdk:"vhost"`
+	Type       types.String   `tfsdk:"type"`
+	AutoDelete types.Bool     `tfsdk:"auto_delete"`
+	Durable    types.Bool     `tfsdk:"durable"`
+	Arguments  types.Dynamic  `tfsdk:"arguments"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the data source type name.
@@ -54,12 +56,15 @@ func (r *exchangeResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *exchangeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *exchangeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage an exchange.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the managed exchange.",
@@ -88,6 +93,7 @@ func (r *exchangeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				// Default:     booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
@@ -105,6 +111,9 @@ func (r *exchangeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -132,6 +141,14 @@ func (r *exchangeResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var request clientlibrary.ExchangeRequest
 	request.Type = plan.Type.ValueString()
 	if !plan.AutoDelete.IsUnknown() {
@@ -141,35 +158,18 @@ func (r *exchangeResource) Create(ctx context.Context, req resource.CreateReques
 		request.Durable = plan.Durable.ValueBoolPointer()
 	}
 
-	argumentsMap := make(map[string]any)
-	if !plan.Arguments.IsNull() && !plan.Arguments.IsUnknown() {
-		switch v := plan.Arguments.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					argumentsMap[key] = val.ValueString()
-				case types.Bool:
-					argumentsMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							argumentsMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							argumentsMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	argumentsMap, err := converters.DynamicToMap(plan.Arguments)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("arguments"), "Invalid exchange arguments", err.Error())
+		return
 	}
 	if len(argumentsMap) > 0 {
 		request.Arguments = argumentsMap
 	}
 
-	err := r.services.Exchanges.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), request)
+	err = r.services.Exchanges.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating exchange", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating exchange", err)
 		return
 	}
 
@@ -179,7 +179,7 @@ func (r *exchangeResource) Create(ctx context.Context, req resource.CreateReques
 	// Read back the exchange to get the actual state from the server
 	exchange, err := r.services.Exchanges.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading exchange after creation", err.Error())
+		addAPIError(&resp.Diagnostics, "Error reading exchange after creation", err)
 		return
 	}
 
@@ -202,47 +202,38 @@ func (r *exchangeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	exchange, err := r.services.Exchanges.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading exchange", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	if exchange == nil {
+	exchange, err := r.services.Exchanges.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Exchange not found on server, removing from state", map[string]any{
+			"vhost": state.Vhost.ValueString(),
+			"name":  state.Name.ValueString(),
+		})
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading exchange", err)
+		return
+	}
 
 	state.Type = types.StringValue(exchange.Type)
 	state.AutoDelete = types.BoolValue(exchange.AutoDelete)
 	state.Durable = types.BoolValue(exchange.Durable)
 
 	if len(exchange.Arguments) > 0 {
-		attributes := make(map[string]attr.Value)
-		for key, value := range exchange.Arguments {
-			switch v := value.(type) {
-			case int64:
-				attributes[key] = types.NumberValue(new(big.Float).SetInt64(v))
-			case float64:
-				attributes[key] = types.NumberValue(new(big.Float).SetFloat64(v))
-			case bool:
-				attributes[key] = types.BoolValue(v)
-			case string:
-				attributes[key] = types.StringValue(v)
-			}
-		}
-
-		attributeTypes := make(map[string]attr.Type)
-		for key := range attributes {
-			attributeTypes[key] = attributes[key].Type(ctx)
-		}
-
-		argumentsObject, diags := types.ObjectValue(attributeTypes, attributes)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
+		state.Arguments, diags = converters.MapToDynamic(ctx, exchange.Arguments)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		state.Arguments = types.DynamicValue(argumentsObject)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -253,7 +244,9 @@ func (r *exchangeResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *exchangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This resource does not implement the Update function
+	// Exchanges cannot be updated and are replaced instead, only the timeouts
+	// block can change in place.
+	updateTimeouts(ctx, req, resp)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -264,9 +257,17 @@ func (r *exchangeResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Exchanges.Delete(ctx, state.Vhost.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting exchange", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting exchange", err)
 		return
 	}
 
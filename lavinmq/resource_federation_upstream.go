@@ -3,12 +3,15 @@ package lavinmq
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,25 +41,27 @@ type federationUpstreamResource struct {
 }
 
 type federationUpstreamResourceModel struct {
-	Name           types.String `tfsdk:"name"`
-	Vhost          types.String `tfsdk:"vhost"`
-	URI            types.String `tfsdk:"uri"`
-	PrefetchCount  types.Int64  `tfsdk:"prefetch_count"`
-	ReconnectDelay types.Int64  `tfsdk:"reconnect_delay"`
-	AckMode        types.String `tfsdk:"ack_mode"`
-	Exchange       types.String `tfsdk:"exchange"`
-	MaxHops        types.Int64  `tfsdk:"max_hops"`
-	Expires        types.Int64  `tfsdk:"expires"`
-	MessageTTL     types.Int64  `tfsdk:"message_ttl"`
-	Queue          types.String `tfsdk:"queue"`
-	ConsumerTag    types.String `tfsdk:"consumer_tag"`
+	Name           types.String   `tfsdk:"name"`
+	Vhost          types.String   `tfsdk:"vhost"`
+	URI            types.String   `tfsdk:"uri"`
+	PrefetchCount  types.Int64    `tfsdk:"prefetch_count"`
+	ReconnectDelay types.Int64    `tfsdk:"reconnect_delay"`
+	AckMode        types.String   `tfsdk:"ack_mode"`
+	Exchange       types.String   `tfsdk:"exchange"`
+	MaxHops        types.Int64    `tfsdk:"max_hops"`
+	Expires        types.Int64    `tfsdk:"expires"`
+	MessageTTL     types.Int64    `tfsdk:"message_ttl"`
+	Queue          types.String   `tfsdk:"queue"`
+	ConsumerTag    types.String   `tfsdk:"consumer_tag"`
+	WaitForRunning types.Bool     `tfsdk:"wait_for_running"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *federationUpstreamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_federation_upstream"
 }
 
-func (r *federationUpstreamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *federationUpstreamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a federation upstream for replicating exchanges and queues from remote brokers.",
 		Attributes: map[string]schema.Attribute{
@@ -149,6 +154,15 @@ func (r *federationUpstreamResource) Schema(_ context.Context, _ resource.Schema
 				Description: "Consumer tag for the federation link.",
 				Optional:    true,
 			},
+			"wait_for_running": schema.BoolAttribute{
+				Description: "Wait on create and update until the federation links of the upstream are running. " +
+					"The apply fails with the broker's error if a link fails to start. " +
+					"Requires a policy that federates exchanges or queues from the upstream to exist before the upstream is created.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -168,6 +182,14 @@ func (r *federationUpstreamResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	federationValue := clientlibrary.FederationUpstreamValue{
 		URI:            plan.URI.ValueString(),
 		PrefetchCount:  plan.PrefetchCount.ValueInt64(),
@@ -187,13 +209,13 @@ func (r *federationUpstreamResource) Create(ctx context.Context, req resource.Cr
 
 	err := r.services.Parameters.CreateOrUpdate(ctx, "federation-upstream", plan.Vhost.ValueString(), plan.Name.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating federation upstream", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating federation upstream", err)
 		return
 	}
 
 	parameter, err := r.services.Parameters.Get(ctx, "federation-upstream", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read federation upstream data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read federation upstream data", err)
 		return
 	}
 
@@ -205,7 +227,10 @@ func (r *federationUpstreamResource) Create(ctx context.Context, req resource.Cr
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "create diag failed")
+		return
 	}
+
+	r.waitForRunning(ctx, plan, &resp.Diagnostics)
 }
 
 func (r *federationUpstreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -215,15 +240,27 @@ func (r *federationUpstreamResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	parameter, err := r.services.Parameters.Get(ctx, "federation-upstream", state.Vhost.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read federation upstream data", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if parameter == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	parameter, err := r.services.Parameters.Get(ctx, "federation-upstream", state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Federation upstream not found on server, removing from state", map[string]any{
+			"vhost": state.Vhost.ValueString(),
+			"name":  state.Name.ValueString(),
+		})
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read federation upstream data", err)
+		return
+	}
 
 	if err := updateFederationUpstreamStateFromParameter(ctx, &state, parameter); err != nil {
 		resp.Diagnostics.AddError("Failed to update state", err.Error())
@@ -240,6 +277,14 @@ func (r *federationUpstreamResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	federationValue := clientlibrary.FederationUpstreamValue{
 		URI:            plan.URI.ValueString(),
 		PrefetchCount:  plan.PrefetchCount.ValueInt64(),
@@ -259,13 +304,13 @@ func (r *federationUpstreamResource) Update(ctx context.Context, req resource.Up
 
 	err := r.services.Parameters.CreateOrUpdate(ctx, "federation-upstream", plan.Vhost.ValueString(), plan.Name.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating federation upstream", err.Error())
+		addAPIError(&resp.Diagnostics, "Error updating federation upstream", err)
 		return
 	}
 
 	parameter, err := r.services.Parameters.Get(ctx, "federation-upstream", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read federation upstream data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read federation upstream data", err)
 		return
 	}
 
@@ -275,6 +320,11 @@ func (r *federationUpstreamResource) Update(ctx context.Context, req resource.Up
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForRunning(ctx, plan, &resp.Diagnostics)
 }
 
 func (r *federationUpstreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,9 +334,17 @@ func (r *federationUpstreamResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Parameters.Delete(ctx, "federation-upstream", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting federation upstream", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting federation upstream", err)
 		return
 	}
 }
@@ -305,6 +363,20 @@ func (r *federationUpstreamResource) ImportState(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
 }
 
+// waitForRunning waits for the federation links of the upstream when
+// wait_for_running is set. The state is saved before waiting, so a failed link
+// leaves the upstream in state to be fixed by the next apply.
+func (r *federationUpstreamResource) waitForRunning(ctx context.Context, plan federationUpstreamResourceModel, diags *diag.Diagnostics) {
+	if !plan.WaitForRunning.ValueBool() {
+		return
+	}
+
+	err := waitForFederationLinks(ctx, r.services, plan.Vhost.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		diags.AddError("Federation link not running", err.Error())
+	}
+}
+
 func updateFederationUpstreamStateFromParameter(ctx context.Context, state *federationUpstreamResourceModel, parameter *clientlibrary.ParameterResponse) error {
 	state.Name = types.StringValue(parameter.Name)
 	state.Vhost = types.StringValue(parameter.Vhost)
@@ -0,0 +1,224 @@
+package lavinmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &globalParameterResource{}
+	_ resource.ResourceWithConfigure   = &globalParameterResource{}
+	_ resource.ResourceWithImportState = &globalParameterResource{}
+)
+
+// NewGlobalParameterResource is a helper function to simplify the provider implementation.
+func NewGlobalParameterResource() resource.Resource {
+	return &globalParameterResource{}
+}
+
+// globalParameterResource is the resource implementation.
+type globalParameterResource struct {
+	services *clientlibrary.Services
+}
+
+type globalParameterResourceModel struct {
+	Name     types.String         `tfsdk:"name"`
+	Value    jsontypes.Normalized `tfsdk:"value"`
+	Timeouts timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *globalParameterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_parameter"
+}
+
+// Schema defines the schema for the resource.
+func (r *globalParameterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a global (cluster-wide) parameter, e.g. cluster_name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the global parameter.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "JSON encoded value of the global parameter, e.g. `jsonencode(\"my-cluster\")`.",
+				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured services to the resource.
+func (r *globalParameterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *globalParameterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalParameterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createReq, diags := globalParameterRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.services.GlobalParameters.CreateOrUpdate(ctx, plan.Name.ValueString(), createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating global parameter", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "create diag failed")
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *globalParameterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalParameterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	parameter, err := r.services.GlobalParameters.Get(ctx, state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Global parameter not found on server, removing from state", map[string]any{
+			"name": state.Name.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read global parameter data", err)
+		return
+	}
+
+	value, err := json.Marshal(parameter.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode global parameter value", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(parameter.Name)
+	state.Value = jsontypes.NewNormalizedValue(string(value))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *globalParameterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan globalParameterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq, diags := globalParameterRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.services.GlobalParameters.CreateOrUpdate(ctx, plan.Name.ValueString(), updateReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating global parameter", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *globalParameterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalParameterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.services.GlobalParameters.Delete(ctx, state.Name.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error deleting global parameter", err)
+		return
+	}
+}
+
+func (r *globalParameterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import resource by global parameter name
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// globalParameterRequest decodes the JSON value of the plan into the request
+// sent to the API. The value is kept as raw JSON, so large integers are sent
+// without losing precision.
+func globalParameterRequest(plan globalParameterResourceModel) (clientlibrary.ParameterRequest, diag.Diagnostics) {
+	var value json.RawMessage
+	diags := plan.Value.Unmarshal(&value)
+	return clientlibrary.ParameterRequest{Value: value}, diags
+}
@@ -0,0 +1,71 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlobalParameter_Basic(t *testing.T) {
+	t.Parallel()
+	globalParameterResourceName := "lavinmq_global_parameter.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_global_parameter" "vcr_test" {
+            name  = "vcr_test_global_parameter"
+            value = jsonencode({
+              enabled = true
+              limit   = 10
+            })
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(globalParameterResourceName, "name", "vcr_test_global_parameter"),
+					resource.TestCheckResourceAttr(globalParameterResourceName, "value", `{"enabled":true,"limit":10}`),
+				),
+			},
+			{
+				ResourceName:                         globalParameterResourceName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        "vcr_test_global_parameter",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: `
+          resource "lavinmq_global_parameter" "vcr_test" {
+            name  = "vcr_test_global_parameter"
+            value = jsonencode({
+              enabled = true
+              limit   = 20
+            })
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(globalParameterResourceName, "value", `{"enabled":true,"limit":20}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlobalParameter_Drift(t *testing.T) {
+	t.Parallel()
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_global_parameter" "vcr_test" {
+            name  = "vcr_test_global_parameter_drift"
+            value = jsonencode("vcr-cluster")
+          }`,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package lavinmq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestResourceReadRemovesNotFound verifies that every resource removes itself
+// from state, instead of failing or panicking, when the object has been
+// deleted outside of Terraform.
+func TestResourceReadRemovesNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not_found","reason":"Object Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client())
+	services := clientlibrary.NewServices(client)
+
+	tests := []struct {
+		name     string
+		resource resource.Resource
+	}{
+		{"binding", NewBindingResource()},
+		{"exchange", NewExchangeResource()},
+		{"federation_upstream", NewFederationUpstreamResource()},
+		{"global_parameter", NewGlobalParameterResource()},
+		{"operator_policy", NewOperatorPolicyResource()},
+		{"parameter", NewParameterResource()},
+		{"permission", NewPermissionResource()},
+		{"policy", NewPolicyResource()},
+		{"queue", NewQueueResource()},
+		{"shovel", NewShovelResource()},
+		{"topic_permission", NewTopicPermissionResource()},
+		{"user", NewUserResource()},
+		{"user_limits", NewUserLimitsResource()},
+		{"vhost", NewVhostResource()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.resource.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: services}, &resource.ConfigureResponse{})
+			state := notFoundTestState(ctx, t, tt.resource)
+			req := resource.ReadRequest{State: state}
+			resp := resource.ReadResponse{State: state}
+
+			tt.resource.Read(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("resource was not removed from state")
+			}
+		})
+	}
+}
+
+// TestShovelActionNotFound verifies that an action on a shovel that does not
+// exist fails, instead of being skipped.
+func TestShovelActionNotFound(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not_found","reason":"Object Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client())
+	r := &shovelActionResource{services: clientlibrary.NewServices(client)}
+
+	diags := r.perform(context.Background(), shovelActionResourceModel{
+		Name:   types.StringValue("missing"),
+		Vhost:  types.StringValue("/"),
+		Action: types.StringValue("restart"),
+	})
+
+	if !diags.HasError() || diags[0].Summary() != "Shovel not found" {
+		t.Errorf("diagnostics = %v, want Shovel not found error", diags)
+	}
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "GET ") {
+		t.Errorf("requests = %q, want only the status lookup", requests)
+	}
+}
+
+// notFoundTestState builds a state for the resource where all top level
+// string attributes are set and everything else is null. JSON string
+// attributes are set to an empty JSON object.
+func notFoundTestState(ctx context.Context, t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		switch {
+		case isJSONAttribute(schemaResp.Schema, name):
+			values[name] = tftypes.NewValue(attrType, "{}")
+		case attrType.Is(tftypes.String):
+			values[name] = tftypes.NewValue(attrType, "test")
+		default:
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func isJSONAttribute(s schema.Schema, name string) bool {
+	attribute, ok := s.Attributes[name].(schema.StringAttribute)
+	if !ok {
+		return false
+	}
+	_, ok = attribute.CustomType.(jsontypes.NormalizedType)
+	return ok
+}
@@ -0,0 +1,134 @@
+package lavinmq
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &operatorPolicyResource{}
+	_ resource.ResourceWithConfigure   = &operatorPolicyResource{}
+	_ resource.ResourceWithImportState = &operatorPolicyResource{}
+)
+
+// NewOperatorPolicyResource is a helper function to simplify the provider implementation.
+func NewOperatorPolicyResource() resource.Resource {
+	return &operatorPolicyResource{
+		policyResource{
+			typeName: "_operator_policy",
+			noun:     "operator policy",
+			service: func(services *clientlibrary.Services) policyService {
+				return services.OperatorPolicies
+			},
+		},
+	}
+}
+
+// operatorPolicyResource is the resource implementation. Operator policies are
+// managed like policies, but only apply to queues and only allow queue limits
+// in the definition.
+type operatorPolicyResource struct {
+	policyResource
+}
+
+// Schema defines the schema for the resource.
+func (r *operatorPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.policyResource.Schema(ctx, req, resp)
+
+	resp.Schema.Description = "Manage an operator policy. Operator policies enforce queue limits regardless of the user policies that apply to the queue."
+	resp.Schema.Attributes["pattern"] = schema.StringAttribute{
+		Description: "Regular expression pattern that matches the names of queues to which the operator policy applies.",
+		Required:    true,
+	}
+	resp.Schema.Attributes["definition"] = schema.DynamicAttribute{
+		Description: "Operator policy definition as a map of key-value pairs. Allowed keys are " + operatorPolicyDefinitionKeysDescription() + ".",
+		Required:    true,
+		Validators: []validator.Dynamic{
+			operatorPolicyDefinitionValidator{},
+		},
+	}
+	resp.Schema.Attributes["apply_to"] = schema.StringAttribute{
+		Description: "What the operator policy applies to, operator policies only apply to 'queues'.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("queues"),
+		},
+	}
+}
+
+// operatorPolicyDefinitionKeys are the definition keys that operator policies
+// allow, all of them take a non-negative integer.
+var operatorPolicyDefinitionKeys = []string{
+	"delivery-limit",
+	"expires",
+	"max-length",
+	"max-length-bytes",
+	"message-ttl",
+}
+
+func operatorPolicyDefinitionKeysDescription() string {
+	keys := make([]string, len(operatorPolicyDefinitionKeys))
+	for i, key := range operatorPolicyDefinitionKeys {
+		keys[i] = "`" + key + "`"
+	}
+	return strings.Join(keys, ", ")
+}
+
+// operatorPolicyDefinitionValidator validates that the definition of an
+// operator policy only contains allowed keys with non-negative integer values.
+type operatorPolicyDefinitionValidator struct{}
+
+func (v operatorPolicyDefinitionValidator) Description(_ context.Context) string {
+	return "definition keys must be one of " + strings.Join(operatorPolicyDefinitionKeys, ", ") + " with non-negative integer values"
+}
+
+func (v operatorPolicyDefinitionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v operatorPolicyDefinitionValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	definition, ok := req.ConfigValue.UnderlyingValue().(types.Object)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid operator policy definition",
+			"The definition must be an object of key-value pairs.")
+		return
+	}
+
+	for key, value := range definition.Attributes() {
+		if !slices.Contains(operatorPolicyDefinitionKeys, key) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid operator policy definition",
+				fmt.Sprintf("Key %q is not allowed in operator policies, allowed keys are %s.",
+					key, strings.Join(operatorPolicyDefinitionKeys, ", ")))
+			continue
+		}
+		if value.IsUnknown() {
+			continue
+		}
+		number, ok := value.(types.Number)
+		if !ok || number.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid operator policy definition",
+				fmt.Sprintf("Value of %q must be a non-negative integer.", key))
+			continue
+		}
+		if bigFloat := number.ValueBigFloat(); !bigFloat.IsInt() || bigFloat.Sign() < 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid operator policy definition",
+				fmt.Sprintf("Value of %q must be a non-negative integer.", key))
+		}
+	}
+}
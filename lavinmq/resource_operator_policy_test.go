@@ -0,0 +1,114 @@
+package lavinmq
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOperatorPolicy_Basic(t *testing.T) {
+	t.Parallel()
+	operatorPolicyResourceName := "lavinmq_operator_policy.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "vcr_test" {
+            name     = "vcr_test_operator_policy"
+            vhost    = "/"
+            pattern  = "^vcr_test"
+            apply_to = "queues"
+            definition = {
+              "max-length"  = 1000
+              "message-ttl" = 60000
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "name", "vcr_test_operator_policy"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "vhost", "/"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "pattern", "^vcr_test"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "apply_to", "queues"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "priority", "0"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "definition.max-length", "1000"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "definition.message-ttl", "60000"),
+				),
+			},
+			{
+				ResourceName:                         operatorPolicyResourceName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        "/@vcr_test_operator_policy",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "vcr_test" {
+            name     = "vcr_test_operator_policy"
+            vhost    = "/"
+            pattern  = "^vcr_test"
+            apply_to = "queues"
+            priority = 10
+            definition = {
+              "max-length-bytes" = 1048576
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(operatorPolicyResourceName, "definition.max-length-bytes", "1048576"),
+					resource.TestCheckNoResourceAttr(operatorPolicyResourceName, "definition.max-length"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOperatorPolicy_InvalidDefinition(t *testing.T) {
+	t.Parallel()
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "vcr_test" {
+            name    = "vcr_test_operator_policy_invalid"
+            vhost   = "/"
+            pattern = "^vcr_test"
+            definition = {
+              "dead-letter-exchange" = "dlx"
+            }
+          }`,
+				ExpectError: regexp.MustCompile(`Key "dead-letter-exchange" is not allowed in operator policies`),
+			},
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "vcr_test" {
+            name    = "vcr_test_operator_policy_invalid"
+            vhost   = "/"
+            pattern = "^vcr_test"
+            definition = {
+              "max-length" = -1
+            }
+          }`,
+				ExpectError: regexp.MustCompile(`Value of "max-length" must be a non-negative integer`),
+			},
+			{
+				Config: `
+          resource "lavinmq_operator_policy" "vcr_test" {
+            name     = "vcr_test_operator_policy_invalid"
+            vhost    = "/"
+            pattern  = "^vcr_test"
+            apply_to = "all"
+            definition = {
+              "max-length" = 1000
+            }
+          }`,
+				ExpectError: regexp.MustCompile(`Attribute apply_to value must be one of`),
+			},
+		},
+	})
+}
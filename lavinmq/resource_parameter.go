@@ -0,0 +1,256 @@
+package lavinmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &parameterResource{}
+	_ resource.ResourceWithConfigure   = &parameterResource{}
+	_ resource.ResourceWithImportState = &parameterResource{}
+)
+
+// NewParameterResource is a helper function to simplify the provider implementation.
+func NewParameterResource() resource.Resource {
+	return &parameterResource{}
+}
+
+// parameterResource is the resource implementation.
+type parameterResource struct {
+	services *clientlibrary.Services
+}
+
+type parameterResourceModel struct {
+	Component types.String         `tfsdk:"component"`
+	Vhost     types.String         `tfsdk:"vhost"`
+	Name      types.String         `tfsdk:"name"`
+	Value     jsontypes.Normalized `tfsdk:"value"`
+	Timeouts  timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *parameterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_parameter"
+}
+
+// Schema defines the schema for the resource.
+func (r *parameterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a runtime parameter of any component, e.g. shovel or federation-upstream.",
+		Attributes: map[string]schema.Attribute{
+			"component": schema.StringAttribute{
+				Description: "Component the parameter belongs to, e.g. shovel or federation-upstream.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vhost": schema.StringAttribute{
+				Description: "Virtual host where the parameter is applied.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the parameter.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "JSON encoded value of the parameter, e.g. `jsonencode({ uri = \"amqp://upstream\" })`. Key order and number formatting do not cause a diff.",
+				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured services to the resource.
+func (r *parameterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *parameterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan parameterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createReq, diags := parameterRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.services.Parameters.CreateOrUpdate(ctx, plan.Component.ValueString(), plan.Vhost.ValueString(), plan.Name.ValueString(), createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating parameter", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "create diag failed")
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *parameterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state parameterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	parameter, err := r.services.Parameters.Get(ctx, state.Component.ValueString(), state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Parameter not found on server, removing from state", map[string]any{
+			"component": state.Component.ValueString(),
+			"vhost":     state.Vhost.ValueString(),
+			"name":      state.Name.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read parameter data", err)
+		return
+	}
+
+	value, err := json.Marshal(parameter.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode parameter value", err.Error())
+		return
+	}
+
+	state.Component = types.StringValue(parameter.Component)
+	state.Vhost = types.StringValue(parameter.Vhost)
+	state.Name = types.StringValue(parameter.Name)
+	state.Value = jsontypes.NewNormalizedValue(string(value))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *parameterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan parameterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq, diags := parameterRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.services.Parameters.CreateOrUpdate(ctx, plan.Component.ValueString(), plan.Vhost.ValueString(), plan.Name.ValueString(), updateReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating parameter", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *parameterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state parameterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.services.Parameters.Delete(ctx, state.Component.ValueString(), state.Vhost.ValueString(), state.Name.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error deleting parameter", err)
+		return
+	}
+}
+
+func (r *parameterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import resource by component@vhost@name (e.g., "shovel@/@my-shovel")
+	parts := strings.Split(req.ID, "@")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: component@vhost@name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("component"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// parameterRequest decodes the JSON value of the plan into the request
+// sent to the API. The value is kept as raw JSON, so large integers are sent
+// without losing precision.
+func parameterRequest(plan parameterResourceModel) (clientlibrary.ParameterRequest, diag.Diagnostics) {
+	var value json.RawMessage
+	diags := plan.Value.Unmarshal(&value)
+	return clientlibrary.ParameterRequest{Value: value}, diags
+}
@@ -0,0 +1,58 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccParameter_Basic(t *testing.T) {
+	t.Parallel()
+	parameterResourceName := "lavinmq_parameter.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_parameter" "vcr_test" {
+            component = "federation-upstream"
+            vhost     = "/"
+            name      = "vcr_test_parameter"
+            value = jsonencode({
+              uri              = "amqp://localhost"
+              "prefetch-count" = 10
+            })
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(parameterResourceName, "component", "federation-upstream"),
+					resource.TestCheckResourceAttr(parameterResourceName, "vhost", "/"),
+					resource.TestCheckResourceAttr(parameterResourceName, "name", "vcr_test_parameter"),
+					resource.TestCheckResourceAttr(parameterResourceName, "value", `{"prefetch-count":10,"uri":"amqp://localhost"}`),
+				),
+			},
+			{
+				ResourceName:                         parameterResourceName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        "federation-upstream@/@vcr_test_parameter",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				// Key order and whitespace differ from the API response, which
+				// must not cause a diff after apply.
+				Config: `
+          resource "lavinmq_parameter" "vcr_test" {
+            component = "federation-upstream"
+            vhost     = "/"
+            name      = "vcr_test_parameter"
+            value     = "{\"uri\": \"amqp://localhost\", \"prefetch-count\": 20}"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(parameterResourceName, "value", `{"uri": "amqp://localhost", "prefetch-count": 20}`),
+				),
+			},
+		},
+	})
+}
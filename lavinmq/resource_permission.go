@@ -2,10 +2,12 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,11 +36,12 @@ type permissionResource struct {
 }
 
 type permissionResourceModel struct {
-	Vhost     types.String `tfsdk:"vhost"`
-	User      types.String `tfsdk:"user"`
-	Configure types.String `tfsdk:"configure"`
-	Read      types.String `tfsdk:"read"`
-	Write     types.String `tfsdk:"write"`
+	Vhost     types.String   `tfsdk:"vhost"`
+	User      types.String   `tfsdk:"user"`
+	Configure types.String   `tfsdk:"configure"`
+	Read      types.String   `tfsdk:"read"`
+	Write     types.String   `tfsdk:"write"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -47,7 +50,7 @@ func (r *permissionResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *permissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *permissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage user permissions for a vhost.",
 		Attributes: map[string]schema.Attribute{
@@ -78,6 +81,9 @@ func (r *permissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -98,6 +104,14 @@ func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq := clientlibrary.PermissionRequest{
 		Configure: plan.Configure.ValueString(),
 		Read:      plan.Read.ValueString(),
@@ -106,7 +120,7 @@ func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequ
 
 	err := r.services.Permissions.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.User.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating permission", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating permission", err)
 		return
 	}
 
@@ -124,15 +138,27 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	permission, err := r.services.Permissions.Get(ctx, state.Vhost.ValueString(), state.User.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read permission data", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if permission == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	permission, err := r.services.Permissions.Get(ctx, state.Vhost.ValueString(), state.User.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Permission not found on server, removing from state", map[string]any{
+			"vhost": state.Vhost.ValueString(),
+			"user":  state.User.ValueString(),
+		})
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read permission data", err)
+		return
+	}
 
 	state.Vhost = types.StringValue(permission.Vhost)
 	state.User = types.StringValue(permission.User)
@@ -151,6 +177,14 @@ func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	updateReq := clientlibrary.PermissionRequest{
 		Configure: plan.Configure.ValueString(),
 		Read:      plan.Read.ValueString(),
@@ -159,7 +193,7 @@ func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	err := r.services.Permissions.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.User.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating permission", err.Error())
+		addAPIError(&resp.Diagnostics, "Error updating permission", err)
 		return
 	}
 
@@ -174,9 +208,17 @@ func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Permissions.Delete(ctx, plan.Vhost.ValueString(), plan.User.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting permission", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting permission", err)
 		return
 	}
 }
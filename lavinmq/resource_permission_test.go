@@ -1,8 +1,11 @@
 package lavinmq
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -151,8 +154,11 @@ func TestAccPermission_Import(t *testing.T) {
 	})
 }
 
-func TestAccPermission_Drift(t *testing.T) {
+func TestAccPermission_CreateBeforeUser(t *testing.T) {
 	t.Parallel()
+	// The permission does not depend on the user, so it can be created before
+	// the user exists. The broker then responds with 404, which is reported
+	// instead of silently leaving the permission out of the state.
 	lavinMQResourceTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -173,8 +179,51 @@ func TestAccPermission_Drift(t *testing.T) {
             write     = ".*"
           }
         `,
-				ExpectNonEmptyPlan: true,
+				ExpectError: regexp.MustCompile(`Error creating permission`),
 			},
 		},
 	})
 }
+
+func TestAccPermission_Drift(t *testing.T) {
+	t.Parallel()
+	config := `
+          resource "lavinmq_user" "test_user" {
+            name     = "vcr_test_user_drift"
+            password = "test_password"
+            tags     = ["management"]
+          }
+
+          resource "lavinmq_permission" "test_permission" {
+            vhost     = "/"
+            user      = lavinmq_user.test_user.name
+            configure = ".*"
+            read      = ".*"
+            write     = ".*"
+          }
+        `
+
+	lavinMQResourceTestWithServices(t, func(services *clientlibrary.Services) resource.TestCase {
+		return resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+				},
+				{
+					PreConfig: func() {
+						permission := clientlibrary.PermissionRequest{Configure: "^$", Read: ".*", Write: "^$"}
+						err := services.Permissions.CreateOrUpdate(context.Background(), "/", "vcr_test_user_drift", permission)
+						if err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config:             config,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		}
+	})
+}
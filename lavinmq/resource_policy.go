@@ -2,13 +2,14 @@ package lavinmq
 
 import (
 	"context"
-	"math/big"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,42 +30,62 @@ var (
 
 // NewPolicyResource is a helper function to simplify the provider implementation.
 func NewPolicyResource() resource.Resource {
-	return &policyResource{}
+	return &policyResource{
+		typeName: "_policy",
+		noun:     "policy",
+		service: func(services *clientlibrary.Services) policyService {
+			return services.Policies
+		},
+	}
 }
 
-// policyResource is the resource implementation.
+// policyService is the part of the API that policies and operator policies
+// have in common, they share the request and response format.
+type policyService interface {
+	CreateOrUpdate(ctx context.Context, vhost, name string, policy clientlibrary.PolicyRequest) error
+	Get(ctx context.Context, vhost, name string) (*clientlibrary.PolicyResponse, error)
+	Delete(ctx context.Context, vhost, name string) error
+}
+
+// policyResource is the resource implementation. It is shared with operator
+// policies, which only differ in the API endpoint and the schema.
 type policyResource struct {
 	services *clientlibrary.Services
+
+	typeName string
+	noun     string
+	service  func(*clientlibrary.Services) policyService
 }
 
 type policyResourceModel struct {
-	Name       types.String  `tfsdk:"name"`
-	Vhost      types.String  `tfsdk:"vhost"`
-	Pattern    types.String  `tfsdk:"pattern"`
-	Definition types.Dynamic `tfsdk:"definition"`
-	Priority   types.Int64   `tfsdk:"priority"`
-	ApplyTo    types.String  `tfsdk:"apply_to"`
+	Name       types.String   `tfsdk:"name"`
+	Vhost      types.String   `tfsdk:"vhost"`
+	Pattern    types.String   `tfsdk:"pattern"`
+	Definition types.Dynamic  `tfsdk:"definition"`
+	Priority   types.Int64    `tfsdk:"priority"`
+	ApplyTo    types.String   `tfsdk:"apply_to"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
 func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_policy"
+	resp.TypeName = req.ProviderTypeName + r.typeName
 }
 
 // Schema defines the schema for the resource.
-func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *policyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manage a policy.",
+		Description: "Manage a " + r.noun + ".",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description: "Name of the policy.",
+				Description: "Name of the " + r.noun + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"vhost": schema.StringAttribute{
-				Description: "Virtual host where the policy is applied.",
+				Description: "Virtual host where the " + r.noun + " is applied.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -79,7 +100,7 @@ func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 			},
 			"priority": schema.Int64Attribute{
-				Description: "Policy priority. Higher numbers indicate higher priority.",
+				Description: capitalize(r.noun) + " priority. Higher numbers indicate higher priority.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -92,6 +113,9 @@ func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -112,45 +136,36 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	definitionMap := make(map[string]any)
-	if !plan.Definition.IsNull() && !plan.Definition.IsUnknown() {
-		switch v := plan.Definition.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					definitionMap[key] = val.ValueString()
-				case types.Bool:
-					definitionMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							definitionMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							definitionMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	definition, err := converters.DynamicToMap(plan.Definition)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid "+r.noun+" definition", err.Error())
+		return
 	}
 
 	createReq := clientlibrary.PolicyRequest{
 		Pattern:    plan.Pattern.ValueString(),
-		Definition: definitionMap,
+		Definition: definition,
 		Priority:   plan.Priority.ValueInt64(),
 		ApplyTo:    plan.ApplyTo.ValueString(),
 	}
 
-	err := r.services.Policies.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), createReq)
+	err = r.service(r.services).CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating policy", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating "+r.noun, err)
 		return
 	}
 
-	policy, err := r.services.Policies.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	policy, err := r.service(r.services).Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read policy data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read "+r.noun+" data", err)
 		return
 	}
 
@@ -171,15 +186,27 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	policy, err := r.services.Policies.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read policy data", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if policy == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	policy, err := r.service(r.services).Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, capitalize(r.noun)+" not found on server, removing from state", map[string]any{
+			"vhost": state.Vhost.ValueString(),
+			"name":  state.Name.ValueString(),
+		})
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read "+r.noun+" data", err)
+		return
+	}
 
 	state.Name = types.StringValue(policy.Name)
 	state.Vhost = types.StringValue(policy.Vhost)
@@ -187,31 +214,11 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.Priority = types.Int64Value(int64(policy.Priority))
 	state.ApplyTo = types.StringValue(policy.ApplyTo)
 
-	attributes := make(map[string]attr.Value)
-	for key, value := range policy.Definition {
-		switch v := value.(type) {
-		case int64:
-			attributes[key] = types.NumberValue(new(big.Float).SetInt64(v))
-		case float64:
-			attributes[key] = types.NumberValue(new(big.Float).SetFloat64(v))
-		case bool:
-			attributes[key] = types.BoolValue(v)
-		case string:
-			attributes[key] = types.StringValue(v)
-		}
-	}
-
-	attributeTypes := make(map[string]attr.Type)
-	for key := range attributes {
-		attributeTypes[key] = attributes[key].Type(ctx)
-	}
-
-	definitionObject, diags := types.ObjectValue(attributeTypes, attributes)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	state.Definition, diags = converters.MapToDynamic(ctx, policy.Definition)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Definition = types.DynamicValue(definitionObject)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -224,45 +231,36 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	definitionMap := make(map[string]any)
-	if !plan.Definition.IsNull() && !plan.Definition.IsUnknown() {
-		switch v := plan.Definition.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					definitionMap[key] = val.ValueString()
-				case types.Bool:
-					definitionMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							definitionMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							definitionMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	definition, err := converters.DynamicToMap(plan.Definition)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid "+r.noun+" definition", err.Error())
+		return
 	}
 
 	updateReq := clientlibrary.PolicyRequest{
 		Pattern:    plan.Pattern.ValueString(),
-		Definition: definitionMap,
+		Definition: definition,
 		Priority:   plan.Priority.ValueInt64(),
 		ApplyTo:    plan.ApplyTo.ValueString(),
 	}
 
-	err := r.services.Policies.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), updateReq)
+	err = r.service(r.services).CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating policy", err.Error())
+		addAPIError(&resp.Diagnostics, "Error updating "+r.noun, err)
 		return
 	}
 
-	policy, err := r.services.Policies.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	policy, err := r.service(r.services).Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read policy data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read "+r.noun+" data", err)
 		return
 	}
 
@@ -280,9 +278,17 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.services.Policies.Delete(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.service(r.services).Delete(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting policy", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting "+r.noun, err)
 		return
 	}
 }
@@ -293,7 +299,7 @@ func (r *policyResource) ImportState(ctx context.Context, req resource.ImportSta
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid import ID format",
-			"Expected format: vhost@policy_name",
+			"Expected format: vhost@"+strings.ReplaceAll(r.noun, " ", "_")+"_name",
 		)
 		return
 	}
@@ -301,3 +307,11 @@ func (r *policyResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
 }
+
+// capitalize returns s with its first letter in upper case.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
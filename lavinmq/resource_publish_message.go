@@ -2,10 +2,12 @@ package lavinmq
 
 import (
 	"context"
-	"math/big"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -30,20 +32,21 @@ type publishMessageResource struct {
 }
 
 type publishMessageResourceModel struct {
-	Vhost                 types.String  `tfsdk:"vhost"`
-	Exchange              types.String  `tfsdk:"exchange"`
-	RoutingKey            types.String  `tfsdk:"routing_key"`
-	Payload               types.String  `tfsdk:"payload"`
-	PayloadEncoding       types.String  `tfsdk:"payload_encoding"`
-	Properties            types.Dynamic `tfsdk:"properties"`
-	PublishMessageCounter types.Int64   `tfsdk:"publish_message_counter"`
+	Vhost                 types.String   `tfsdk:"vhost"`
+	Exchange              types.String   `tfsdk:"exchange"`
+	RoutingKey            types.String   `tfsdk:"routing_key"`
+	Payload               types.String   `tfsdk:"payload"`
+	PayloadEncoding       types.String   `tfsdk:"payload_encoding"`
+	Properties            types.Dynamic  `tfsdk:"properties"`
+	PublishMessageCounter types.Int64    `tfsdk:"publish_message_counter"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *publishMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_publish_message"
 }
 
-func (r *publishMessageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *publishMessageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Publishes a message to an exchange. This is a one-time action resource.",
 		Attributes: map[string]schema.Attribute{
@@ -86,6 +89,9 @@ func (r *publishMessageResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
 
@@ -113,6 +119,14 @@ func (r *publishMessageResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	request, diags := r.populateRequest(plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -121,7 +135,7 @@ func (r *publishMessageResource) Create(ctx context.Context, req resource.Create
 
 	err := r.services.Messages.Publish(ctx, plan.Vhost.ValueString(), plan.Exchange.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error publishing message", err.Error())
+		addAPIError(&resp.Diagnostics, "Error publishing message", err)
 		return
 	}
 
@@ -142,6 +156,25 @@ func (r *publishMessageResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Changing only the timeouts block must not publish the message again.
+	onlyTimeouts, diags := onlyTimeoutsChanged(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if onlyTimeouts {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	request, diags := r.populateRequest(plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -150,7 +183,7 @@ func (r *publishMessageResource) Update(ctx context.Context, req resource.Update
 
 	err := r.services.Messages.Publish(ctx, plan.Vhost.ValueString(), plan.Exchange.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error publishing message", err.Error())
+		addAPIError(&resp.Diagnostics, "Error publishing message", err)
 		return
 	}
 
@@ -165,27 +198,11 @@ func (r *publishMessageResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *publishMessageResource) populateRequest(plan publishMessageResourceModel) (clientlibrary.PublishRequest, diag.Diagnostics) {
-	propertiesMap := make(map[string]any)
-	if !plan.Properties.IsNull() && !plan.Properties.IsUnknown() {
-		switch v := plan.Properties.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					propertiesMap[key] = val.ValueString()
-				case types.Bool:
-					propertiesMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							propertiesMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							propertiesMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	var diags diag.Diagnostics
+	propertiesMap, err := converters.DynamicToMap(plan.Properties)
+	if err != nil {
+		diags.AddAttributeError(path.Root("properties"), "Invalid message properties", err.Error())
+		return clientlibrary.PublishRequest{}, diags
 	}
 
 	// Default properties values
@@ -203,5 +220,5 @@ func (r *publishMessageResource) populateRequest(plan publishMessageResourceMode
 		Properties:      propertiesMap,
 	}
 
-	return request, nil
+	return request, diags
 }
@@ -2,19 +2,25 @@ package lavinmq
 
 import (
 	"context"
-	"math/big"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -38,13 +44,25 @@ type queueResource struct {
 
 // queueResourceModel is the
 type queueResourceModel struct {
-	Name       types.String  `tfsdk:"name"`
-	Vhost      types.String  `tfsdk:"vhost"`
-	AutoDelete types.Bool    `tfsdk:"auto_delete"`
-	Durable    types.Bool    `tfsdk:"durable"`
-	Arguments  types.Dynamic `tfsdk:"arguments"`
-	Pause      types.Bool    `tfsdk:"pause"`
-	State      types.String  `tfsdk:"state"`
+	Name                      types.String   `tfsdk:"name"`
+	Vhost                     types.String   `tfsdk:"vhost"`
+	AutoDelete                types.Bool     `tfsdk:"auto_delete"`
+	Durable                   types.Bool     `tfsdk:"durable"`
+	QueueType                 types.String   `tfsdk:"queue_type"`
+	MaxAge                    types.String   `tfsdk:"max_age"`
+	MaxLengthBytes            types.Int64    `tfsdk:"max_length_bytes"`
+	StreamMaxSegmentSizeBytes types.Int64    `tfsdk:"stream_max_segment_size_bytes"`
+	Arguments                 types.Dynamic  `tfsdk:"arguments"`
+	ArgumentsUpdateMode       types.String   `tfsdk:"arguments_update_mode"`
+	DeleteIfEmpty             types.Bool     `tfsdk:"delete_if_empty"`
+	DeleteIfUnused            types.Bool     `tfsdk:"delete_if_unused"`
+	Pause                     types.Bool     `tfsdk:"pause"`
+	State                     types.String   `tfsdk:"state"`
+	Consumers                 types.Int64    `tfsdk:"consumers"`
+	Messages                  types.Int64    `tfsdk:"messages"`
+	Ready                     types.Int64    `tfsdk:"ready"`
+	Unacked                   types.Int64    `tfsdk:"unacked"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the data source type name.
@@ -53,7 +71,7 @@ func (r *queueResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *queueResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a queue.",
 		Attributes: map[string]schema.Attribute{
@@ -76,6 +94,7 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
@@ -84,9 +103,71 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"queue_type": schema.StringAttribute{
+				Description: "Type of the queue: 'classic' or 'stream'. Streams must be durable and cannot be auto deleted. " +
+					"Set from the x-queue-type argument when it is set in arguments. When neither is set, the queue gets " +
+					"the default queue type of the vhost and queue_type is not set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("classic", "stream"),
+					queueTypeValidator{},
+				},
+			},
+			"max_age": schema.StringAttribute{
+				Description: "Retention period of a stream, messages older than it are removed. " +
+					"A number followed by a unit: Y, M, D, h, m or s, e.g. '7D'. Only valid for streams.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(maxAgeRegexp, "must be a number followed by Y, M, D, h, m or s, e.g. '7D'"),
+					streamQueueValidator{},
+				},
+			},
+			"max_length_bytes": schema.Int64Attribute{
+				Description: "Maximum total size of the messages in the queue in bytes. " +
+					"Streams remove their oldest segments and classic queues drop messages from the head when it is exceeded.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"stream_max_segment_size_bytes": schema.Int64Attribute{
+				Description: "Maximum size of the segment files of a stream in bytes. Retention removes whole segments. Only valid for streams.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					streamQueueValidator{},
+				},
+			},
+			"delete_if_empty": schema.BoolAttribute{
+				Description: "Only delete the queue when it has no messages. Destroying or replacing a queue with messages fails.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"delete_if_unused": schema.BoolAttribute{
+				Description: "Only delete the queue when it has no consumers. Destroying or replacing a queue with consumers fails.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"pause": schema.BoolAttribute{
 				Description: "Queue action, when true, the queue will be paused.",
 				Optional:    true,
@@ -100,10 +181,64 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "State of the queue: 'running', 'paused', 'flow', 'closed', or 'deleted'.",
 				Computed:    true,
 			},
+			"consumers": schema.Int64Attribute{
+				Description: "Number of consumers subscribed to the queue when it was last read.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"messages": schema.Int64Attribute{
+				Description: "Number of messages in the queue when it was last read.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready": schema.Int64Attribute{
+				Description: "Number of messages ready to be delivered to consumers when the queue was last read.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"unacked": schema.Int64Attribute{
+				Description: "Number of messages delivered to consumers but not yet acknowledged when the queue was last read.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"arguments": schema.DynamicAttribute{
-				Description: "Optional queue arguments (e.g. x-message-ttl, x-max-length, x-dead-letter-exchange).",
-				Optional:    true,
+				Description: "Optional queue arguments (e.g. x-message-ttl, x-max-length, x-dead-letter-exchange). " +
+					"Queue arguments are immutable, changing them replaces the queue unless arguments_update_mode is 'policy'.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					queueArgumentsPlanModifier(),
+				},
+				Validators: []validator.Dynamic{
+					queueArgumentsConflictValidator{},
+				},
 			},
+			"arguments_update_mode": schema.StringAttribute{
+				Description: "How changed arguments are applied to an existing queue: 'replace' or 'policy'. " +
+					"With 'replace' the queue is deleted and declared again, and its messages are lost. " +
+					"With 'policy' added x-message-ttl, x-max-length, x-dead-letter-exchange and x-dead-letter-routing-key arguments, " +
+					"and lowered x-message-ttl and x-max-length arguments, are applied through a provider managed policy " +
+					"named terraform-queue-arguments-{queue name} with priority 100. LavinMQ applies only one policy per queue, so the managed policy " +
+					"replaces any other policy matching the queue with a lower priority, including its definition, and a policy with a higher priority " +
+					"disables the managed policy, which is reported as a warning. " +
+					"Other argument changes still replace the queue. Switching back to 'replace' deletes the managed policy. Defaults to 'replace'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("replace"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("replace", "policy"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -140,6 +275,14 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var request clientlibrary.QueueRequest
 	if !plan.AutoDelete.IsUnknown() {
 		request.AutoDelete = plan.AutoDelete.ValueBoolPointer()
@@ -148,47 +291,36 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 		request.Durable = plan.Durable.ValueBoolPointer()
 	}
 
-	argumentsMap := make(map[string]any)
-	if !plan.Arguments.IsNull() && !plan.Arguments.IsUnknown() {
-		switch v := plan.Arguments.UnderlyingValue().(type) {
-		case types.Object:
-			for key, value := range v.Attributes() {
-				switch val := value.(type) {
-				case types.String:
-					argumentsMap[key] = val.ValueString()
-				case types.Bool:
-					argumentsMap[key] = val.ValueBool()
-				case types.Number:
-					if bigFloat := val.ValueBigFloat(); bigFloat != nil {
-						if intVal, accuracy := bigFloat.Int64(); accuracy == big.Exact {
-							argumentsMap[key] = intVal
-						} else if floatVal, accuracy := bigFloat.Float64(); accuracy == big.Exact {
-							argumentsMap[key] = floatVal
-						}
-					}
-				}
-			}
-		}
+	argumentsMap, err := converters.DynamicToMap(plan.Arguments)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("arguments"), "Invalid queue arguments", err.Error())
+		return
+	}
+	for key, value := range typedQueueArguments(plan) {
+		argumentsMap[key] = value
 	}
 	if len(argumentsMap) > 0 {
 		request.Arguments = argumentsMap
 	}
 
-	err := r.services.Queues.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), request)
+	err = r.services.Queues.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.Name.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating queue", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating queue", err)
 		return
 	}
 
 	queue, err := r.services.Queues.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading queue", err.Error())
+		addAPIError(&resp.Diagnostics, "Error reading queue", err)
 		return
 	}
 
 	plan.AutoDelete = types.BoolValue(queue.AutoDelete)
 	plan.Durable = types.BoolValue(queue.Durable)
 	plan.State = types.StringValue(queue.State)
+	plan.QueueType = queueTypeValue(queue.Arguments)
+	setQueueMetrics(&plan, queue)
+	resp.Diagnostics.Append(setDeclaredQueueArguments(ctx, resp.Private, queue.Arguments)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -204,12 +336,16 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	queue, err := r.services.Queues.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading queue", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if queue == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	queue, err := r.services.Queues.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
 		tflog.Info(ctx, "Queue not found on server, removing from state", map[string]any{
 			"vhost": state.Vhost.ValueString(),
 			"name":  state.Name.ValueString(),
@@ -217,38 +353,45 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading queue", err)
+		return
+	}
 
 	state.AutoDelete = types.BoolValue(queue.AutoDelete)
 	state.Durable = types.BoolValue(queue.Durable)
 	state.State = types.StringValue(queue.State)
 	state.Pause = types.BoolValue(queue.State == "paused")
+	setQueueMetrics(&state, queue)
 
-	if len(queue.Arguments) > 0 {
-		attributes := make(map[string]attr.Value)
-		for key, value := range queue.Arguments {
-			switch v := value.(type) {
-			case int64:
-				attributes[key] = types.NumberValue(new(big.Float).SetInt64(v))
-			case float64:
-				attributes[key] = types.NumberValue(new(big.Float).SetFloat64(v))
-			case bool:
-				attributes[key] = types.BoolValue(v)
-			case string:
-				attributes[key] = types.StringValue(v)
-			}
-		}
+	if state.ArgumentsUpdateMode.IsNull() {
+		state.ArgumentsUpdateMode = types.StringValue("replace")
+	}
+	if state.DeleteIfEmpty.IsNull() {
+		state.DeleteIfEmpty = types.BoolValue(false)
+	}
+	if state.DeleteIfUnused.IsNull() {
+		state.DeleteIfUnused = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(setDeclaredQueueArguments(ctx, resp.Private, queue.Arguments)...)
 
-		attributeTypes := make(map[string]attr.Type)
-		for key := range attributes {
-			attributeTypes[key] = attributes[key].Type(ctx)
+	arguments := queue.Arguments
+	if state.ArgumentsUpdateMode.ValueString() == "policy" {
+		arguments, diags, err = r.effectiveQueueArguments(ctx, state, queue)
+		resp.Diagnostics.Append(diags...)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error reading queue arguments policy", err)
+			return
 		}
+	}
 
-		argumentsObject, diags := types.ObjectValue(attributeTypes, attributes)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
+	arguments = setTypedQueueArguments(&state, arguments)
+	if len(arguments) > 0 {
+		state.Arguments, diags = converters.MapToDynamic(ctx, arguments)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		state.Arguments = types.DynamicValue(argumentsObject)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -267,21 +410,39 @@ func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if plan.Pause.ValueBool() != state.Pause.ValueBool() {
-		err := r.services.Queues.Pause(ctx, state.Vhost.ValueString(), state.Name.ValueString(), plan.Pause.ValueBool())
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating queue pause state", err.Error())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The arguments plan modifier only allows in place argument changes that
+	// can be applied through the managed policy.
+	if !plan.Arguments.Equal(state.Arguments) || !plan.ArgumentsUpdateMode.Equal(state.ArgumentsUpdateMode) {
+		if err := r.updateArgumentsPolicy(ctx, plan, state); err != nil {
+			addAPIError(&resp.Diagnostics, "Error updating queue arguments policy", err)
 			return
 		}
+	}
 
-		queue, err := r.services.Queues.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	if plan.Pause.ValueBool() != state.Pause.ValueBool() {
+		err := r.services.Queues.Pause(ctx, state.Vhost.ValueString(), state.Name.ValueString(), plan.Pause.ValueBool())
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading queue", err.Error())
+			addAPIError(&resp.Diagnostics, "Error updating queue pause state", err)
 			return
 		}
+	}
 
-		plan.State = types.StringValue(queue.State)
+	// The state is unknown in every update plan, also when only the timeouts
+	// block changes, so it is always read back.
+	queue, err := r.services.Queues.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading queue", err)
+		return
 	}
+	plan.State = types.StringValue(queue.State)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -297,11 +458,59 @@ func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.services.Queues.Delete(ctx, state.Vhost.ValueString(), state.Name.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.services.Queues.Delete(ctx, state.Vhost.ValueString(), state.Name.ValueString(), clientlibrary.QueueDeleteOptions{
+		IfEmpty:  state.DeleteIfEmpty.ValueBool(),
+		IfUnused: state.DeleteIfUnused.ValueBool(),
+	})
+	var notEmptyErr *clientlibrary.QueueNotEmptyError
+	if errors.As(err, &notEmptyErr) {
+		hint := "disable prevent_destroy_when_non_empty in the provider configuration"
+		if state.DeleteIfEmpty.ValueBool() {
+			hint = "set delete_if_empty to false"
+		}
+		resp.Diagnostics.AddError(
+			"Queue not empty",
+			notEmptyErr.Error()+". Purge the queue, e.g. with lavinmq_queue_action, or "+hint+" to delete it.",
+		)
+		return
+	}
+	var inUseErr *clientlibrary.QueueInUseError
+	if errors.As(err, &inUseErr) {
+		resp.Diagnostics.AddError(
+			"Queue in use",
+			inUseErr.Error()+". Stop the consumers of the queue, or set delete_if_unused to false to delete it.",
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting queue", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting queue", err)
 		return
 	}
 
+	if state.ArgumentsUpdateMode.ValueString() == "policy" {
+		err = r.services.Policies.Delete(ctx, state.Vhost.ValueString(), queueArgumentsPolicyName(state.Name.ValueString()))
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error deleting queue arguments policy", err)
+			return
+		}
+	}
+
 	resp.State.RemoveResource(ctx)
 }
+
+// setQueueMetrics sets the consumer and message counts of the queue. They
+// are refreshed on every read and kept from state on update.
+func setQueueMetrics(model *queueResourceModel, queue *clientlibrary.QueueResponse) {
+	model.Consumers = types.Int64Value(queue.Consumers)
+	model.Messages = types.Int64Value(queue.Messages)
+	model.Ready = types.Int64Value(queue.Ready)
+	model.Unacked = types.Int64Value(queue.Unacked)
+}
@@ -2,10 +2,12 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,9 +36,10 @@ type queueActionResource struct {
 
 // queueActionResourceModel is the
 type queueActionResourceModel struct {
-	Name   types.String `tfsdk:"name"`
-	Vhost  types.String `tfsdk:"vhost"`
-	Action types.String `tfsdk:"action"`
+	Name     types.String   `tfsdk:"name"`
+	Vhost    types.String   `tfsdk:"vhost"`
+	Action   types.String   `tfsdk:"action"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the data source type name.
@@ -45,7 +48,7 @@ func (r *queueActionResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *queueActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *queueActionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a queue.",
 		Attributes: map[string]schema.Attribute{
@@ -74,6 +77,9 @@ func (r *queueActionResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
 	}
 }
 
@@ -104,15 +110,23 @@ func (r *queueActionResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	queue, err := r.services.Queues.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
-	if err != nil {
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err := r.services.Queues.Get(ctx, plan.Vhost.ValueString(), plan.Name.ValueString())
+	if err != nil && !errors.Is(err, clientlibrary.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error Reading Queue",
 			"Could not read queue with name "+plan.Name.ValueString()+": "+err.Error(),
 		)
 		return
 	}
-	if queue == nil {
+	if errors.Is(err, clientlibrary.ErrNotFound) {
 		tflog.Warn(ctx, "Queue not found", map[string]any{
 			"vhost": plan.Vhost.ValueString(),
 			"name":  plan.Name.ValueString(),
@@ -149,7 +163,9 @@ func (r *queueActionResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *queueActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This resource does not implement the Update function
+	// Actions are performed on create, only the timeouts block can change in
+	// place.
+	updateTimeouts(ctx, req, resp)
 }
 
 func (r *queueActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
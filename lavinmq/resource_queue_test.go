@@ -1,9 +1,11 @@
 package lavinmq
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccQueue_Import(t *testing.T) {
@@ -75,6 +77,347 @@ func TestAccQueue_WithArguments(t *testing.T) {
 	})
 }
 
+func TestAccQueue_Stream(t *testing.T) {
+	t.Parallel()
+	queueResourceName := "lavinmq_queue.test_stream"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test_stream" {
+            name                          = "vcr_test_stream"
+            vhost                         = "/"
+            durable                       = true
+            auto_delete                   = false
+            queue_type                    = "stream"
+            max_age                       = "7D"
+            max_length_bytes              = 1000000000
+            stream_max_segment_size_bytes = 50000000
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "name", "vcr_test_stream"),
+					resource.TestCheckResourceAttr(queueResourceName, "queue_type", "stream"),
+					resource.TestCheckResourceAttr(queueResourceName, "max_age", "7D"),
+					resource.TestCheckResourceAttr(queueResourceName, "max_length_bytes", "1000000000"),
+					resource.TestCheckResourceAttr(queueResourceName, "stream_max_segment_size_bytes", "50000000"),
+					resource.TestCheckNoResourceAttr(queueResourceName, "arguments"),
+				),
+			},
+			{
+				ResourceName:                         queueResourceName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        "/@vcr_test_stream",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func TestAccQueue_StreamArguments(t *testing.T) {
+	t.Parallel()
+	queueResourceName := "lavinmq_queue.test_stream"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test_stream" {
+            name        = "vcr_test_stream_arguments"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+            max_age     = "1h"
+            arguments = {
+              x-queue-type = "stream"
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "queue_type", "stream"),
+					resource.TestCheckResourceAttr(queueResourceName, "max_age", "1h"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-queue-type", "stream"),
+					resource.TestCheckNoResourceAttr(queueResourceName, "arguments.x-max-age"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueue_StreamInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "max_age_on_classic_queue",
+			config: `
+          resource "lavinmq_queue" "test_stream" {
+            name    = "vcr_test_stream_invalid"
+            vhost   = "/"
+            max_age = "7D"
+          }`,
+			err: `only applies to streams`,
+		},
+		{
+			name: "invalid_max_age",
+			config: `
+          resource "lavinmq_queue" "test_stream" {
+            name       = "vcr_test_stream_invalid"
+            vhost      = "/"
+            queue_type = "stream"
+            max_age    = "7 days"
+          }`,
+			err: `must be a number followed by Y, M, D, h, m or s`,
+		},
+		{
+			name: "non_durable_stream",
+			config: `
+          resource "lavinmq_queue" "test_stream" {
+            name       = "vcr_test_stream_invalid"
+            vhost      = "/"
+            durable    = false
+            queue_type = "stream"
+          }`,
+			err: `Streams must be durable`,
+		},
+		{
+			name: "conflicting_arguments",
+			config: `
+          resource "lavinmq_queue" "test_stream" {
+            name       = "vcr_test_stream_invalid"
+            vhost      = "/"
+            queue_type = "stream"
+            arguments = {
+              x-queue-type = "stream"
+            }
+          }`,
+			err: `conflicts with attribute queue_type`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lavinMQResourceTest(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      tt.config,
+						ExpectError: regexp.MustCompile(tt.err),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccQueue_ArgumentsReplace(t *testing.T) {
+	t.Parallel()
+	queueResourceName := "lavinmq_queue.test_queue"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name        = "vcr_test_queue_arguments_replace"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+            arguments = {
+              x-message-ttl = 60000
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "arguments_update_mode", "replace"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-message-ttl", "60000"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name        = "vcr_test_queue_arguments_replace"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+            arguments = {
+              x-message-ttl = 30000
+            }
+          }`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(queueResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-message-ttl", "30000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueue_ArgumentsPolicy(t *testing.T) {
+	t.Parallel()
+	queueResourceName := "lavinmq_queue.test_queue"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name                  = "vcr_test_queue_arguments_policy"
+            vhost                 = "/"
+            durable               = true
+            auto_delete           = false
+            arguments_update_mode = "policy"
+            arguments = {
+              x-message-ttl = 60000
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "arguments_update_mode", "policy"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-message-ttl", "60000"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name                  = "vcr_test_queue_arguments_policy"
+            vhost                 = "/"
+            durable               = true
+            auto_delete           = false
+            arguments_update_mode = "policy"
+            arguments = {
+              x-message-ttl          = 30000
+              x-dead-letter-exchange = "dlx"
+            }
+          }`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(queueResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-message-ttl", "30000"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-dead-letter-exchange", "dlx"),
+					resource.TestCheckResourceAttrSet(queueResourceName, "state"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name                  = "vcr_test_queue_arguments_policy"
+            vhost                 = "/"
+            durable               = true
+            auto_delete           = false
+            arguments_update_mode = "policy"
+            arguments = {
+              x-message-ttl          = 30000
+              x-dead-letter-exchange = "dlx"
+              x-max-priority         = 5
+            }
+          }`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(queueResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-message-ttl", "30000"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-dead-letter-exchange", "dlx"),
+					resource.TestCheckResourceAttr(queueResourceName, "arguments.x-max-priority", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueue_DeleteConditions(t *testing.T) {
+	t.Parallel()
+	queueResourceName := "lavinmq_queue.test_queue"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_queue" "test_queue" {
+            name             = "vcr_test_queue_delete_conditions"
+            vhost            = "/"
+            durable          = true
+            auto_delete      = false
+            delete_if_empty  = true
+            delete_if_unused = true
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "delete_if_empty", "true"),
+					resource.TestCheckResourceAttr(queueResourceName, "delete_if_unused", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueue_PreventDestroyWhenNonEmpty(t *testing.T) {
+	t.Parallel()
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          provider "lavinmq" {
+            prevent_destroy_when_non_empty = true
+          }
+
+          resource "lavinmq_queue" "test_queue" {
+            name        = "vcr_test_queue_prevent_destroy"
+            vhost       = "/"
+            durable     = true
+            auto_delete = false
+          }
+
+          resource "lavinmq_publish_message" "test_message" {
+            vhost       = "/"
+            exchange    = "amq.default"
+            routing_key = lavinmq_queue.test_queue.name
+            payload     = "VCR test publish"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lavinmq_queue.test_queue", "name", "vcr_test_queue_prevent_destroy"),
+				),
+			},
+			{
+				Config: `
+          provider "lavinmq" {
+            prevent_destroy_when_non_empty = true
+          }`,
+				ExpectError: regexp.MustCompile(`it\s+contains\s+1\s+messages`),
+			},
+			{
+				Config: `
+          provider "lavinmq" {
+            prevent_destroy_when_non_empty = false
+          }`,
+			},
+		},
+	})
+}
+
 func TestAccQueue_PauseUnpause(t *testing.T) {
 	t.Parallel()
 	queueResourceName := "lavinmq_queue.test_queue"
@@ -3,12 +3,15 @@ package lavinmq
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,27 +41,31 @@ type shovelResource struct {
 }
 
 type shovelResourceModel struct {
-	Name             types.String `tfsdk:"name"`
-	Vhost            types.String `tfsdk:"vhost"`
-	SrcURI           types.String `tfsdk:"src_uri"`
-	DestURI          types.String `tfsdk:"dest_uri"`
-	SrcQueue         types.String `tfsdk:"src_queue"`
-	SrcExchange      types.String `tfsdk:"src_exchange"`
-	SrcExchangeKey   types.String `tfsdk:"src_exchange_key"`
-	DestQueue        types.String `tfsdk:"dest_queue"`
-	DestExchange     types.String `tfsdk:"dest_exchange"`
-	DestExchangeKey  types.String `tfsdk:"dest_exchange_key"`
-	SrcPrefetchCount types.Int64  `tfsdk:"src_prefetch_count"`
-	SrcDeleteAfter   types.String `tfsdk:"src_delete_after"`
-	ReconnectDelay   types.Int64  `tfsdk:"reconnect_delay"`
-	AckMode          types.String `tfsdk:"ack_mode"`
+	Name             types.String   `tfsdk:"name"`
+	Vhost            types.String   `tfsdk:"vhost"`
+	SrcURI           types.String   `tfsdk:"src_uri"`
+	DestURI          types.String   `tfsdk:"dest_uri"`
+	SrcQueue         types.String   `tfsdk:"src_queue"`
+	SrcExchange      types.String   `tfsdk:"src_exchange"`
+	SrcExchangeKey   types.String   `tfsdk:"src_exchange_key"`
+	DestQueue        types.String   `tfsdk:"dest_queue"`
+	DestExchange     types.String   `tfsdk:"dest_exchange"`
+	DestExchangeKey  types.String   `tfsdk:"dest_exchange_key"`
+	SrcPrefetchCount types.Int64    `tfsdk:"src_prefetch_count"`
+	SrcDeleteAfter   types.String   `tfsdk:"src_delete_after"`
+	ReconnectDelay   types.Int64    `tfsdk:"reconnect_delay"`
+	AckMode          types.String   `tfsdk:"ack_mode"`
+	WaitForRunning   types.Bool     `tfsdk:"wait_for_running"`
+	State            types.String   `tfsdk:"state"`
+	LastError        types.String   `tfsdk:"last_error"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *shovelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_shovel"
 }
 
-func (r *shovelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *shovelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a shovel for message forwarding between queues and exchanges.",
 		Attributes: map[string]schema.Attribute{
@@ -150,6 +157,23 @@ func (r *shovelResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringvalidator.OneOf("on-confirm", "on-publish", "no-ack"),
 				},
 			},
+			"wait_for_running": schema.BoolAttribute{
+				Description: "Wait on create and update until the shovel is running. " +
+					"The apply fails with the last error of the shovel as soon as it is in error or terminated state, " +
+					"or if it is not running before the create or update timeout.",
+				Optional: true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Runtime state of the shovel as reported by the broker, e.g. 'Running' or 'Error'.",
+				Computed:    true,
+			},
+			"last_error": schema.StringAttribute{
+				Description: "Last error reported by the broker for the shovel, empty when the shovel is healthy.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -169,6 +193,14 @@ func (r *shovelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if err := validateShovelSource(plan); err != nil {
 		resp.Diagnostics.AddError("Invalid shovel source configuration", err.Error())
 		return
@@ -200,13 +232,13 @@ func (r *shovelResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	err := r.services.Parameters.CreateOrUpdate(ctx, "shovel", plan.Vhost.ValueString(), plan.Name.ValueString(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating shovel", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating shovel", err)
 		return
 	}
 
 	parameter, err := r.services.Parameters.Get(ctx, "shovel", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read shovel data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read shovel data", err)
 		return
 	}
 
@@ -215,9 +247,19 @@ func (r *shovelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if err := r.updateStateFromStatus(ctx, &plan); err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read shovel status", err)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "create diag failed")
+		return
+	}
+
+	if r.waitForRunning(ctx, &plan, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
 
@@ -228,21 +270,38 @@ func (r *shovelResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	parameter, err := r.services.Parameters.Get(ctx, "shovel", state.Vhost.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read shovel data", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if parameter == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	parameter, err := r.services.Parameters.Get(ctx, "shovel", state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Shovel not found on server, removing from state", map[string]any{
+			"vhost": state.Vhost.ValueString(),
+			"name":  state.Name.ValueString(),
+		})
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read shovel data", err)
+		return
+	}
 
 	if err := updateStateFromParameter(ctx, &state, parameter); err != nil {
 		resp.Diagnostics.AddError("Failed to update state", err.Error())
 		return
 	}
 
+	if err := r.updateStateFromStatus(ctx, &state); err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read shovel status", err)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -253,6 +312,14 @@ func (r *shovelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if err := validateShovelSource(plan); err != nil {
 		resp.Diagnostics.AddError("Invalid shovel source configuration", err.Error())
 		return
@@ -284,13 +351,13 @@ func (r *shovelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	err := r.services.Parameters.CreateOrUpdate(ctx, "shovel", plan.Vhost.ValueString(), plan.Name.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating shovel", err.Error())
+		addAPIError(&resp.Diagnostics, "Error updating shovel", err)
 		return
 	}
 
 	parameter, err := r.services.Parameters.Get(ctx, "shovel", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read shovel data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read shovel data", err)
 		return
 	}
 
@@ -299,7 +366,19 @@ func (r *shovelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if err := r.updateStateFromStatus(ctx, &plan); err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read shovel status", err)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.waitForRunning(ctx, &plan, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
 }
 
 func (r *shovelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -309,9 +388,17 @@ func (r *shovelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Parameters.Delete(ctx, "shovel", plan.Vhost.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting shovel", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting shovel", err)
 		return
 	}
 }
@@ -330,6 +417,45 @@ func (r *shovelResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
 }
 
+// updateStateFromStatus sets state and last_error from the runtime status of
+// the shovel. Both are null when the broker has not started the shovel yet.
+func (r *shovelResource) updateStateFromStatus(ctx context.Context, state *shovelResourceModel) error {
+	shovel, err := r.services.Shovels.Get(ctx, state.Vhost.ValueString(), state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		state.State = types.StringNull()
+		state.LastError = types.StringNull()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	state.State = types.StringValue(shovel.State)
+	state.LastError = types.StringValue(shovel.Error)
+	return nil
+}
+
+// waitForRunning waits for the shovel when wait_for_running is set and
+// reports whether the status in plan was refreshed. The state is saved before
+// waiting, so a shovel that never starts stays in state.
+func (r *shovelResource) waitForRunning(ctx context.Context, plan *shovelResourceModel, diags *diag.Diagnostics) bool {
+	if !plan.WaitForRunning.ValueBool() {
+		return false
+	}
+
+	err := waitForShovel(ctx, r.services, plan.Vhost.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		diags.AddError("Shovel not running", err.Error())
+		return false
+	}
+
+	if err := r.updateStateFromStatus(ctx, plan); err != nil {
+		addAPIError(diags, "Failed to read shovel status", err)
+		return false
+	}
+	return true
+}
+
 func validateShovelSource(model shovelResourceModel) error {
 	hasSrcQueue := !model.SrcQueue.IsNull() && !model.SrcQueue.IsUnknown() && model.SrcQueue.ValueString() != ""
 	hasSrcExchange := !model.SrcExchange.IsNull() && !model.SrcExchange.IsUnknown() && model.SrcExchange.ValueString() != ""
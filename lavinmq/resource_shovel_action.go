@@ -0,0 +1,201 @@
+package lavinmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &shovelActionResource{}
+	_ resource.ResourceWithConfigure = &shovelActionResource{}
+)
+
+// NewShovelActionResource is a helper function to simplify the provider implementation.
+func NewShovelActionResource() resource.Resource {
+	return &shovelActionResource{}
+}
+
+// shovelActionResource is the resource implementation.
+type shovelActionResource struct {
+	services *clientlibrary.Services
+}
+
+type shovelActionResourceModel struct {
+	Name          types.String   `tfsdk:"name"`
+	Vhost         types.String   `tfsdk:"vhost"`
+	Action        types.String   `tfsdk:"action"`
+	ActionCounter types.Int64    `tfsdk:"action_counter"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *shovelActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shovel_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *shovelActionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restart, pause or resume a shovel. This is a one-time action resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the shovel.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vhost": schema.StringAttribute{
+				Description: "The vhost the shovel is located in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Action to perform on the shovel. Valid values are `restart`, `pause` and `resume`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("restart", "pause", "resume"),
+				},
+			},
+			"action_counter": schema.Int64Attribute{
+				Description: "A counter that can be changed to perform the action again.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *shovelActionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+func (r *shovelActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan shovelActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.perform(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *shovelActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// This resource does not implement the Read function
+}
+
+func (r *shovelActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan shovelActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Changing only the timeouts block must not perform the action again.
+	onlyTimeouts, diags := onlyTimeoutsChanged(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !onlyTimeouts {
+		resp.Diagnostics.Append(r.perform(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *shovelActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource does not implement the Delete function
+}
+
+// perform runs the action on the shovel. A shovel that does not exist is
+// reported as an error, since the action would otherwise silently be lost.
+func (r *shovelActionResource) perform(ctx context.Context, plan shovelActionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	vhost, name := plan.Vhost.ValueString(), plan.Name.ValueString()
+
+	_, err := r.services.Shovels.Get(ctx, vhost, name)
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		diags.AddError("Shovel not found",
+			fmt.Sprintf("Shovel %q does not exist in vhost %q, the %s action cannot be performed.", name, vhost, plan.Action.ValueString()))
+		return diags
+	}
+	if err != nil {
+		addAPIError(&diags, "Failed to read shovel status", err)
+		return diags
+	}
+
+	switch action := strings.ToLower(plan.Action.ValueString()); action {
+	case "restart":
+		err = r.services.Shovels.Restart(ctx, vhost, name)
+	case "pause":
+		err = r.services.Shovels.Pause(ctx, vhost, name)
+	case "resume":
+		err = r.services.Shovels.Resume(ctx, vhost, name)
+	default:
+		diags.AddError("Invalid Action", "Action must be one of: restart, pause, resume.")
+		return diags
+	}
+	if err != nil {
+		addAPIError(&diags, "Error performing shovel action "+plan.Action.ValueString(), err)
+	}
+	return diags
+}
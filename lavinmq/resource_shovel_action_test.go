@@ -0,0 +1,109 @@
+package lavinmq
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShovelAction_Basic(t *testing.T) {
+	t.Parallel()
+	shovelActionResourceName := "lavinmq_shovel_action.test_action"
+
+	// Set sanitized value for playback and use real value for recording
+	testSrcDestURI := "TEST_AMQP_URI"
+	if os.Getenv("LAVINMQ_RECORD") != "" {
+		testSrcDestURI = os.Getenv("TEST_AMQP_URI")
+	}
+
+	shovelConfig := fmt.Sprintf(`
+		resource "lavinmq_shovel" "test_shovel" {
+			name       = "vcr_test_shovel_action"
+			vhost      = "/"
+			src_uri    = "%[1]s"
+			dest_uri   = "%[1]s"
+			src_queue  = "source_queue"
+			dest_queue = "dest_queue"
+		}`, testSrcDestURI)
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: shovelConfig + `
+					resource "lavinmq_shovel_action" "test_action" {
+						name   = lavinmq_shovel.test_shovel.name
+						vhost  = lavinmq_shovel.test_shovel.vhost
+						action = "pause"
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(shovelActionResourceName, "name", "vcr_test_shovel_action"),
+					resource.TestCheckResourceAttr(shovelActionResourceName, "vhost", "/"),
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action", "pause"),
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action_counter", "1"),
+				),
+			},
+			{
+				Config: shovelConfig + `
+					resource "lavinmq_shovel_action" "test_action" {
+						name   = lavinmq_shovel.test_shovel.name
+						vhost  = lavinmq_shovel.test_shovel.vhost
+						action = "resume"
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action", "resume"),
+				),
+			},
+			{
+				Config: shovelConfig + `
+					resource "lavinmq_shovel_action" "test_action" {
+						name           = lavinmq_shovel.test_shovel.name
+						vhost          = lavinmq_shovel.test_shovel.vhost
+						action         = "restart"
+						action_counter = 2
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action", "restart"),
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action_counter", "2"),
+				),
+			},
+			{
+				Config: shovelConfig + `
+					resource "lavinmq_shovel_action" "test_action" {
+						name           = lavinmq_shovel.test_shovel.name
+						vhost          = lavinmq_shovel.test_shovel.vhost
+						action         = "restart"
+						action_counter = 3
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action", "restart"),
+					resource.TestCheckResourceAttr(shovelActionResourceName, "action_counter", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShovelAction_InvalidAction(t *testing.T) {
+	t.Parallel()
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "lavinmq_shovel_action" "test_action" {
+						name   = "vcr_test_shovel_action_invalid"
+						vhost  = "/"
+						action = "stop"
+					}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
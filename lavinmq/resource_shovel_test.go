@@ -40,6 +40,8 @@ func TestAccShovel_Import(t *testing.T) {
 					resource.TestCheckResourceAttr(shovelResourceName, "dest_queue", "dest_queue"),
 					resource.TestCheckResourceAttr(shovelResourceName, "src_prefetch_count", "1000"),
 					resource.TestCheckResourceAttr(shovelResourceName, "ack_mode", "on-confirm"),
+					resource.TestCheckResourceAttr(shovelResourceName, "state", "Running"),
+					resource.TestCheckResourceAttr(shovelResourceName, "last_error", ""),
 				),
 			},
 			{
@@ -109,6 +111,42 @@ func TestAccShovel_Update(t *testing.T) {
 	})
 }
 
+func TestAccShovel_WaitForRunning(t *testing.T) {
+	t.Parallel()
+	shovelResourceName := "lavinmq_shovel.test_shovel"
+
+	// Set sanitized value for playback and use real value for recording
+	testSrcDestURI := "TEST_AMQP_URI"
+	if os.Getenv("LAVINMQ_RECORD") != "" {
+		testSrcDestURI = os.Getenv("TEST_AMQP_URI")
+	}
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "lavinmq_shovel" "test_shovel" {
+						name             = "vcr_test_shovel_wait"
+						vhost            = "/"
+						src_uri          = "%[1]s"
+						dest_uri         = "%[1]s"
+						src_queue        = "source_queue"
+						dest_queue       = "dest_queue"
+						wait_for_running = true
+					}`, testSrcDestURI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(shovelResourceName, "name", "vcr_test_shovel_wait"),
+					resource.TestCheckResourceAttr(shovelResourceName, "wait_for_running", "true"),
+					resource.TestCheckResourceAttr(shovelResourceName, "state", "Running"),
+					resource.TestCheckResourceAttr(shovelResourceName, "last_error", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccShovel_QueueToQueue(t *testing.T) {
 	t.Parallel()
 	shovelResourceName := "lavinmq_shovel.test_q2q"
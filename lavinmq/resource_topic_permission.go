@@ -0,0 +1,246 @@
+package lavinmq
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &topicPermissionResource{}
+	_ resource.ResourceWithConfigure   = &topicPermissionResource{}
+	_ resource.ResourceWithImportState = &topicPermissionResource{}
+)
+
+// NewTopicPermissionResource is a helper function to simplify the provider implementation.
+func NewTopicPermissionResource() resource.Resource {
+	return &topicPermissionResource{}
+}
+
+// topicPermissionResource is the resource implementation.
+type topicPermissionResource struct {
+	services *clientlibrary.Services
+}
+
+type topicPermissionResourceModel struct {
+	Vhost    types.String   `tfsdk:"vhost"`
+	User     types.String   `tfsdk:"user"`
+	Exchange types.String   `tfsdk:"exchange"`
+	Write    types.String   `tfsdk:"write"`
+	Read     types.String   `tfsdk:"read"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *topicPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topic_permission"
+}
+
+// Schema defines the schema for the resource.
+func (r *topicPermissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage user topic permissions for an exchange in a vhost. The API can only remove all topic permissions " +
+			"of a user in a vhost, so deleting one removes them all and writes back the permissions for the other exchanges. " +
+			"This is not atomic: permissions that fail to be written back are listed in the error and must be restored manually.",
+		Attributes: map[string]schema.Attribute{
+			"vhost": schema.StringAttribute{
+				Description: "Virtual host where the permission is applied.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Name of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange": schema.StringAttribute{
+				Description: "Name of the topic exchange the permission is applied to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"write": schema.StringAttribute{
+				Description: "Regular expression pattern for routing keys the user can publish with.",
+				Required:    true,
+			},
+			"read": schema.StringAttribute{
+				Description: "Regular expression pattern for routing keys the user can bind with.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured services to the resource.
+func (r *topicPermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *topicPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan topicPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createReq := clientlibrary.TopicPermissionRequest{
+		Exchange: plan.Exchange.ValueString(),
+		Write:    plan.Write.ValueString(),
+		Read:     plan.Read.ValueString(),
+	}
+
+	err := r.services.TopicPermissions.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.User.ValueString(), createReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating topic permission", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "create diag failed")
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *topicPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state topicPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	permission, err := r.services.TopicPermissions.Get(ctx, state.Vhost.ValueString(), state.User.ValueString(), state.Exchange.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Topic permission not found on server, removing from state", map[string]any{
+			"vhost":    state.Vhost.ValueString(),
+			"user":     state.User.ValueString(),
+			"exchange": state.Exchange.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read topic permission data", err)
+		return
+	}
+
+	state.Vhost = types.StringValue(permission.Vhost)
+	state.User = types.StringValue(permission.User)
+	state.Exchange = types.StringValue(permission.Exchange)
+	state.Write = types.StringValue(permission.Write)
+	state.Read = types.StringValue(permission.Read)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *topicPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan topicPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq := clientlibrary.TopicPermissionRequest{
+		Exchange: plan.Exchange.ValueString(),
+		Write:    plan.Write.ValueString(),
+		Read:     plan.Read.ValueString(),
+	}
+
+	err := r.services.TopicPermissions.CreateOrUpdate(ctx, plan.Vhost.ValueString(), plan.User.ValueString(), updateReq)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating topic permission", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *topicPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan topicPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.services.TopicPermissions.Delete(ctx, plan.Vhost.ValueString(), plan.User.ValueString(), plan.Exchange.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error deleting topic permission", err)
+		return
+	}
+}
+
+func (r *topicPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import resource by vhost@user@exchange (e.g., "/@my-user@amq.topic")
+	parts := strings.Split(req.ID, "@")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: vhost@user@exchange",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange"), parts[2])...)
+}
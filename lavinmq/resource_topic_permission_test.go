@@ -0,0 +1,66 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTopicPermission_Basic(t *testing.T) {
+	t.Parallel()
+	topicPermissionResourceName := "lavinmq_topic_permission.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_user" "vcr_test" {
+            name     = "vcr_test_topic_user"
+            password = "test_password"
+          }
+
+          resource "lavinmq_topic_permission" "vcr_test" {
+            vhost    = "/"
+            user     = lavinmq_user.vcr_test.name
+            exchange = "amq.topic"
+            write    = "^tenant-a\\."
+            read     = ".*"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "vhost", "/"),
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "user", "vcr_test_topic_user"),
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "exchange", "amq.topic"),
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "write", "^tenant-a\\."),
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "read", ".*"),
+				),
+			},
+			{
+				ResourceName:                         topicPermissionResourceName,
+				ImportStateVerifyIdentifierAttribute: "user",
+				ImportStateId:                        "/@vcr_test_topic_user@amq.topic",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: `
+          resource "lavinmq_user" "vcr_test" {
+            name     = "vcr_test_topic_user"
+            password = "test_password"
+          }
+
+          resource "lavinmq_topic_permission" "vcr_test" {
+            vhost    = "/"
+            user     = lavinmq_user.vcr_test.name
+            exchange = "amq.topic"
+            write    = "^tenant-(a|b)\\."
+            read     = ".*"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(topicPermissionResourceName, "write", "^tenant-(a|b)\\."),
+				),
+			},
+		},
+	})
+}
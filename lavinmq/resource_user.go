@@ -2,12 +2,14 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
 	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -48,6 +50,7 @@ type userResourceModel struct {
 	PasswordVersion types.Int64            `tfsdk:"password_version"`
 	PasswordHash    *userPasswordHashModel `tfsdk:"password_hash"`
 	Tags            types.List             `tfsdk:"tags"`
+	Timeouts        timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type userPasswordHashModel struct {
@@ -61,7 +64,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a user.",
 		Attributes: map[string]schema.Attribute{
@@ -134,6 +137,9 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -156,6 +162,14 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var request clientlibrary.UserRequest
 
 	// Password or PasswordHash must be set
@@ -180,7 +194,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		request.Password = config.Password.ValueString()
 	}
 
-	if !plan.Tags.IsNull() {
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		var tags []string
 		diags := plan.Tags.ElementsAs(ctx, &tags, false)
 		if diags.HasError() {
@@ -192,10 +206,14 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	err := r.services.Users.CreateOrUpdate(ctx, plan.Name.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating user", err)
 		return
 	}
 
+	if plan.Tags.IsUnknown() {
+		plan.Tags, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "created diag failed")
@@ -210,17 +228,28 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	if state.Name.IsUnknown() {
 		tflog.Info(ctx, fmt.Sprintf("import resource with name identifier %s", state.Name))
 	}
 
 	user, err := r.services.Users.Get(ctx, state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read user data", err.Error())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "User not found on server, removing from state", map[string]any{
+			"name": state.Name.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
 		return
 	}
-	if user == nil {
-		resp.State.RemoveResource(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read user data", err)
 		return
 	}
 
@@ -253,6 +282,14 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Password or PasswordHash must be set
 	if config.Password.IsNull() && config.PasswordHash == nil {
 		resp.Diagnostics.AddError(
@@ -277,7 +314,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	if !plan.Tags.IsNull() {
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		var tags []string
 		diags := plan.Tags.ElementsAs(ctx, &tags, false)
 		if diags.HasError() {
@@ -289,10 +326,14 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	err := r.services.Users.CreateOrUpdate(ctx, plan.Name.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating user", err.Error())
+		addAPIError(&resp.Diagnostics, "Error updating user", err)
 		return
 	}
 
+	if plan.Tags.IsUnknown() {
+		plan.Tags, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "update diag failed")
@@ -307,9 +348,17 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Users.Delete(ctx, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting user", err)
 		return
 	}
 }
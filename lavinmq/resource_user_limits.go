@@ -0,0 +1,215 @@
+package lavinmq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userLimitsResource{}
+	_ resource.ResourceWithConfigure   = &userLimitsResource{}
+	_ resource.ResourceWithImportState = &userLimitsResource{}
+)
+
+// NewUserLimitsResource is a helper function to simplify the provider implementation.
+func NewUserLimitsResource() resource.Resource {
+	return &userLimitsResource{}
+}
+
+// userLimitsResource is the resource implementation.
+type userLimitsResource struct {
+	services *clientlibrary.Services
+}
+
+type userLimitsResourceModel struct {
+	User           types.String   `tfsdk:"user"`
+	MaxConnections types.Int64    `tfsdk:"max_connections"`
+	MaxChannels    types.Int64    `tfsdk:"max_channels"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *userLimitsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_limits"
+}
+
+// Schema defines the schema for the resource.
+func (r *userLimitsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the connection and channel limits of a user.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Description: "Name of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_connections": schema.Int64Attribute{
+				Description: "Limit the number of connections the user can open.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtLeastOneOf(path.MatchRoot("max_channels")),
+				},
+			},
+			"max_channels": schema.Int64Attribute{
+				Description: "Limit the number of channels the user can open on each connection.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// Configure adds the provider configured services to the resource.
+func (r *userLimitsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.services = req.ProviderData.(*clientlibrary.Services)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userLimitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userLimitsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.services.UserLimits.Update(ctx, plan.User.ValueString(), userLimits(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error setting user limits", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "create diag failed")
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userLimitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userLimitsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	limits, err := r.services.UserLimits.Get(ctx, state.User.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "User not found on server, removing user limits from state", map[string]any{
+			"user": state.User.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Failed to read user limits data", err)
+		return
+	}
+
+	state.MaxConnections = types.Int64PointerValue(limits.Value.MaxConnections)
+	state.MaxChannels = types.Int64PointerValue(limits.Value.MaxChannels)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userLimitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userLimitsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.services.UserLimits.Update(ctx, plan.User.ValueString(), userLimits(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error setting user limits", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userLimitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userLimitsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.services.UserLimits.Update(ctx, state.User.ValueString(), clientlibrary.UserLimits{})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error removing user limits", err)
+		return
+	}
+}
+
+func (r *userLimitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import resource by user name
+	resource.ImportStatePassthroughID(ctx, path.Root("user"), req, resp)
+}
+
+// userLimits returns the limits of the plan, unset limits are nil.
+func userLimits(plan userLimitsResourceModel) clientlibrary.UserLimits {
+	return clientlibrary.UserLimits{
+		MaxConnections: plan.MaxConnections.ValueInt64Pointer(),
+		MaxChannels:    plan.MaxChannels.ValueInt64Pointer(),
+	}
+}
@@ -0,0 +1,60 @@
+package lavinmq
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserLimits_Basic(t *testing.T) {
+	t.Parallel()
+	userLimitsResourceName := "lavinmq_user_limits.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_user" "vcr_test" {
+            name     = "vcr_test_user_limits"
+            password = "test_password"
+          }
+
+          resource "lavinmq_user_limits" "vcr_test" {
+            user            = lavinmq_user.vcr_test.name
+            max_connections = 10
+            max_channels    = 64
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userLimitsResourceName, "user", "vcr_test_user_limits"),
+					resource.TestCheckResourceAttr(userLimitsResourceName, "max_connections", "10"),
+					resource.TestCheckResourceAttr(userLimitsResourceName, "max_channels", "64"),
+				),
+			},
+			{
+				ResourceName:                         userLimitsResourceName,
+				ImportStateVerifyIdentifierAttribute: "user",
+				ImportStateId:                        "vcr_test_user_limits",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: `
+          resource "lavinmq_user" "vcr_test" {
+            name     = "vcr_test_user_limits"
+            password = "test_password"
+          }
+
+          resource "lavinmq_user_limits" "vcr_test" {
+            user            = lavinmq_user.vcr_test.name
+            max_connections = 20
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userLimitsResourceName, "max_connections", "20"),
+					resource.TestCheckNoResourceAttr(userLimitsResourceName, "max_channels"),
+				),
+			},
+		},
+	})
+}
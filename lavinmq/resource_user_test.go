@@ -1,9 +1,20 @@
 package lavinmq
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -229,3 +240,56 @@ func TestAccUser_Import(t *testing.T) {
 		},
 	})
 }
+
+// TestUserCreateWithoutTags verifies that a user created without tags, whose
+// planned tags are unknown, is created without tags and stored with an empty
+// tag list instead of an unknown value.
+func TestUserCreateWithoutTags(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = strings.TrimSpace(string(b))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := NewUserResource()
+	r.(frameworkresource.ResourceWithConfigure).Configure(ctx, frameworkresource.ConfigureRequest{
+		ProviderData: clientlibrary.NewServices(clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client())),
+	}, &frameworkresource.ConfigureResponse{})
+
+	var schemaResp frameworkresource.SchemaResponse
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(password, tags any) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "user")
+		values["password"] = tftypes.NewValue(tftypes.String, password)
+		values["password_version"] = tftypes.NewValue(tftypes.Number, 1)
+		values["tags"] = tftypes.NewValue(objectType.AttributeTypes["tags"], tags)
+		return tftypes.NewValue(objectType, values)
+	}
+
+	req := frameworkresource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value("password", nil)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(nil, tftypes.UnknownValue)},
+	}
+	resp := frameworkresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if body != `{"password":"password","tags":""}` {
+		t.Errorf("unexpected request body %s", body)
+	}
+	var tags types.List
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if tags.IsUnknown() || tags.IsNull() || len(tags.Elements()) != 0 {
+		t.Errorf("expected an empty tag list, got %s", tags)
+	}
+}
@@ -2,16 +2,25 @@ package lavinmq
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/cloudamqp/terraform-provider-lavinmq/lavinmq/converters"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,11 +42,25 @@ type vhostResource struct {
 	services *clientlibrary.Services
 }
 
+// vhostImportedKey marks imported vhosts in private state until the first
+// read, which then also reads back the attributes that are only set when
+// configured.
+const vhostImportedKey = "imported"
+
+// vhostTagsImportedKey marks imported vhosts in private state until tags are
+// configured, so that the tags set on the broker are kept while they are not.
+const vhostTagsImportedKey = "tags_imported"
+
 // vhostResourceModel is the
 type vhostResourceModel struct {
-	Name           types.String `tfsdk:"name"`
-	MaxConnections types.Int64  `tfsdk:"max_connections"`
-	MaxQueues      types.Int64  `tfsdk:"max_queues"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             types.List     `tfsdk:"tags"`
+	DefaultQueueType types.String   `tfsdk:"default_queue_type"`
+	MaxConnections   types.Int64    `tfsdk:"max_connections"`
+	MaxQueues        types.Int64    `tfsdk:"max_queues"`
+	Limits           types.Map      `tfsdk:"limits"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the data source type name.
@@ -46,7 +69,7 @@ func (r *vhostResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *vhostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *vhostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage a vhost.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +80,30 @@ func (r *vhostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "Description of the vhost.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "List of tags associated with the vhost. Removing the tags from the configuration removes " +
+					"them from the vhost, except for imported vhosts whose tags were never configured, which keep the tags set on the broker.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					&vhostTagsModifier{},
+				},
+			},
+			"default_queue_type": schema.StringAttribute{
+				Description: "Type of queues declared in the vhost without an `x-queue-type` argument, e.g. `classic` or `stream`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"max_connections": schema.Int64Attribute{
 				Description: "Limit the number of connections for the vhost.",
 				Optional:    true,
@@ -73,6 +120,20 @@ func (r *vhostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"limits": schema.MapAttribute{
+				Description: "Limits of the vhost keyed by limit type, e.g. `max-connections` or `max-queues`. " +
+					"Any limit type supported by the broker can be set, and limits on the broker that are not in the map are removed. " +
+					"Conflicts with `max_connections` and `max_queues`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("max_connections"), path.MatchRoot("max_queues")),
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
@@ -94,12 +155,50 @@ func (r *vhostResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	err := r.services.Vhosts.CreateOrUpdate(ctx, plan.Name.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	request, diags := vhostRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.services.Vhosts.CreateOrUpdate(ctx, plan.Name.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating user", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating vhost", err)
 		return
 	}
 
+	if plan.Tags.IsUnknown() {
+		plan.Tags, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+	var configTags types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	if !configTags.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, vhostTagsImportedKey, nil)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Limits.IsNull() {
+		var limits map[string]int64
+		resp.Diagnostics.Append(plan.Limits.ElementsAs(ctx, &limits, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.services.VhostLimits.Sync(ctx, plan.Name.ValueString(), nil, limits); err != nil {
+			addAPIError(&resp.Diagnostics, "Error setting limits", err)
+			return
+		}
+	}
+
 	var limits clientlibrary.VhostLimits
 	updateLimits := false
 	if !plan.MaxConnections.IsNull() {
@@ -113,7 +212,8 @@ func (r *vhostResource) Create(ctx context.Context, req resource.CreateRequest,
 	if updateLimits {
 		err := r.services.VhostLimits.Update(ctx, plan.Name.ValueString(), limits)
 		if err != nil {
-			resp.Diagnostics.AddError("Error setting limits", err.Error())
+			addAPIError(&resp.Diagnostics, "Error setting limits", err)
+			return
 		}
 	}
 
@@ -131,21 +231,70 @@ func (r *vhostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	if state.Name.IsUnknown() {
 		tflog.Info(ctx, fmt.Sprintf("import resource with name identifier %s", state.Name))
 	}
 
 	vhost, err := r.services.Vhosts.Get(ctx, state.Name.ValueString())
+	if errors.Is(err, clientlibrary.ErrNotFound) {
+		tflog.Info(ctx, "Vhost not found on server, removing from state", map[string]any{
+			"name": state.Name.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read vhost data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read vhost data", err)
 		return
 	}
 
 	state.Name = types.StringValue(vhost.Name)
+	if vhost.Description != "" {
+		state.Description = types.StringValue(vhost.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+	state.Tags, _ = types.ListValue(types.StringType, converters.StringsToAttrValues(vhost.Tags))
+	// The broker reports a default queue type for every vhost, so it is only
+	// read back when configured or imported. Brokers that do not report it
+	// keep the configured value.
+	imported, diags := req.Private.GetKey(ctx, vhostImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if vhost.DefaultQueueType != "" && (!state.DefaultQueueType.IsNull() || imported != nil) {
+		state.DefaultQueueType = types.StringValue(vhost.DefaultQueueType)
+	}
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, vhostImportedKey, nil)...)
+	}
+
+	// Vhosts managing limits with the limits map own every limit, so all
+	// limits on the broker are read to detect drift.
+	if !state.Limits.IsNull() {
+		limits, err := r.services.VhostLimits.List(ctx, state.Name.ValueString())
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Failed to read limits data", err)
+			return
+		}
+		state.Limits, diags = types.MapValueFrom(ctx, types.Int64Type, limits)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
 
 	limits, err := r.services.VhostLimits.Get(ctx, state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read limits data", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to read limits data", err)
 		return
 	}
 
@@ -170,12 +319,85 @@ func (r *vhostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vhostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan vhostResourceModel
+	var plan, state vhostResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only update the vhost itself when its metadata changed, limits are
+	// managed separately.
+	if !plan.Description.Equal(state.Description) || !plan.Tags.Equal(state.Tags) ||
+		!plan.DefaultQueueType.Equal(state.DefaultQueueType) {
+		request, diags := vhostRequest(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.services.Vhosts.CreateOrUpdate(ctx, plan.Name.ValueString(), request); err != nil {
+			addAPIError(&resp.Diagnostics, "Error updating vhost", err)
+			return
+		}
+	}
+	if plan.Tags.IsUnknown() {
+		plan.Tags, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+	var configTags types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	if !configTags.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, vhostTagsImportedKey, nil)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Limits.IsNull() {
+		var current, limits map[string]int64
+		resp.Diagnostics.Append(plan.Limits.ElementsAs(ctx, &limits, false)...)
+		if state.Limits.IsNull() {
+			// Switching from max_connections and max_queues, read what is set.
+			var err error
+			current, err = r.services.VhostLimits.List(ctx, plan.Name.ValueString())
+			if err != nil {
+				addAPIError(&resp.Diagnostics, "Failed to read limits data", err)
+			}
+		} else {
+			resp.Diagnostics.Append(state.Limits.ElementsAs(ctx, &current, false)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.services.VhostLimits.Sync(ctx, plan.Name.ValueString(), current, limits); err != nil {
+			addAPIError(&resp.Diagnostics, "Error setting limits", err)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// Switching from the limits map, remove every limit it set before setting
+	// max_connections and max_queues.
+	if !state.Limits.IsNull() {
+		var current map[string]int64
+		resp.Diagnostics.Append(state.Limits.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.services.VhostLimits.Sync(ctx, plan.Name.ValueString(), current, nil); err != nil {
+			addAPIError(&resp.Diagnostics, "Error removing limits", err)
+			return
+		}
+	}
+
 	var limits clientlibrary.VhostLimits
 	if plan.MaxConnections.IsNull() {
 		limits.MaxConnections = nil
@@ -189,7 +411,8 @@ func (r *vhostResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	err := r.services.VhostLimits.Update(ctx, plan.Name.ValueString(), limits)
 	if err != nil {
-		resp.Diagnostics.AddError("Error setting limits", err.Error())
+		addAPIError(&resp.Diagnostics, "Error setting limits", err)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -206,14 +429,70 @@ func (r *vhostResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.services.Vhosts.Delete(ctx, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		addAPIError(&resp.Diagnostics, "Error deleting vhost", err)
 		return
 	}
 }
 
+// vhostRequest builds the vhost metadata sent to the API from the plan.
+func vhostRequest(ctx context.Context, plan vhostResourceModel) (clientlibrary.VhostRequest, diag.Diagnostics) {
+	request := clientlibrary.VhostRequest{
+		Description:      plan.Description.ValueString(),
+		DefaultQueueType: plan.DefaultQueueType.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		var tags []string
+		diags = plan.Tags.ElementsAs(ctx, &tags, false)
+		request.Tags = strings.Join(tags, ",")
+	}
+	return request, diags
+}
+
 func (r *vhostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import resource by name argument
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, vhostImportedKey, []byte("true"))...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, vhostTagsImportedKey, []byte("true"))...)
+}
+
+// vhostTagsModifier plans an empty list when tags are not configured, so that
+// removing them from the configuration removes them from the vhost. Imported
+// vhosts keep the tags set on the broker until tags are configured.
+type vhostTagsModifier struct{}
+
+func (m *vhostTagsModifier) Description(ctx context.Context) string {
+	return "Tags that are not configured are removed, unless the vhost was imported."
+}
+
+func (m *vhostTagsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *vhostTagsModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, vhostTagsImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if imported != nil && !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue, _ = types.ListValue(types.StringType, []attr.Value{})
 }
@@ -1,8 +1,12 @@
 package lavinmq
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -72,3 +76,147 @@ func TestAccVhost_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccVhost_Metadata(t *testing.T) {
+	t.Parallel()
+	vhostResourceName := "lavinmq_vhost.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name               = "vcr_test_metadata"
+            description        = "Test vhost"
+            tags               = ["team-a", "critical"]
+            default_queue_type = "classic"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vhostResourceName, "name", "vcr_test_metadata"),
+					resource.TestCheckResourceAttr(vhostResourceName, "description", "Test vhost"),
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.0", "team-a"),
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.1", "critical"),
+					resource.TestCheckResourceAttr(vhostResourceName, "default_queue_type", "classic"),
+				),
+			},
+			{
+				ResourceName:                         vhostResourceName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        "vcr_test_metadata",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name               = "vcr_test_metadata"
+            description        = "Updated test vhost"
+            tags               = ["team-a"]
+            default_queue_type = "classic"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vhostResourceName, "description", "Updated test vhost"),
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.0", "team-a"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name               = "vcr_test_metadata"
+            description        = "Updated test vhost"
+            default_queue_type = "classic"
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vhostResourceName, "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVhost_Limits(t *testing.T) {
+	t.Parallel()
+	vhostResourceName := "lavinmq_vhost.vcr_test"
+
+	lavinMQResourceTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name = "vcr_test_limits"
+            limits = {
+              "max-connections" = 100
+              "max-queues"      = 10
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vhostResourceName, "limits.%", "2"),
+					resource.TestCheckResourceAttr(vhostResourceName, "limits.max-connections", "100"),
+					resource.TestCheckResourceAttr(vhostResourceName, "limits.max-queues", "10"),
+					resource.TestCheckNoResourceAttr(vhostResourceName, "max_connections"),
+					resource.TestCheckNoResourceAttr(vhostResourceName, "max_queues"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name = "vcr_test_limits"
+            limits = {
+              "max-connections" = 200
+            }
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vhostResourceName, "limits.%", "1"),
+					resource.TestCheckResourceAttr(vhostResourceName, "limits.max-connections", "200"),
+				),
+			},
+			{
+				Config: `
+          resource "lavinmq_vhost" "vcr_test" {
+            name            = "vcr_test_limits"
+            max_connections = 50
+          }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(vhostResourceName, "limits.%"),
+					resource.TestCheckResourceAttr(vhostResourceName, "max_connections", "50"),
+					resource.TestCheckNoResourceAttr(vhostResourceName, "max_queues"),
+				),
+			},
+		},
+	})
+}
+
+func TestVhostTagsModifier(t *testing.T) {
+	tags, _ := types.ListValue(types.StringType, []attr.Value{types.StringValue("team-a")})
+	empty, _ := types.ListValue(types.StringType, []attr.Value{})
+
+	tests := []struct {
+		name   string
+		config types.List
+		state  types.List
+		want   types.List
+	}{
+		{"configured", tags, empty, tags},
+		{"removed", types.ListNull(types.StringType), tags, empty},
+		{"created without tags", types.ListNull(types.StringType), types.ListNull(types.StringType), empty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.ListRequest{ConfigValue: tt.config, StateValue: tt.state, PlanValue: tt.config}
+			resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+			(&vhostTagsModifier{}).PlanModifyList(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("got %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
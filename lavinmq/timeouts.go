@@ -0,0 +1,40 @@
+package lavinmq
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// defaultOperationTimeout bounds create, read, update and delete operations
+// when the timeouts block of the resource does not set a value.
+const defaultOperationTimeout = 20 * time.Minute
+
+// updateTimeouts stores the planned timeouts block in state. It is used by
+// resources where every other attribute requires replacement, so changing the
+// timeouts block is the only update that can be planned.
+func updateTimeouts(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planTimeouts timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &planTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), planTimeouts)...)
+}
+
+// onlyTimeoutsChanged reports whether the planned update only changes the
+// timeouts block, which is never sent to the broker.
+func onlyTimeoutsChanged(ctx context.Context, req resource.UpdateRequest) (bool, diag.Diagnostics) {
+	var planTimeouts timeouts.Value
+	diags := req.Plan.GetAttribute(ctx, path.Root("timeouts"), &planTimeouts)
+	if diags.HasError() {
+		return false, diags
+	}
+	state := req.State
+	diags.Append(state.SetAttribute(ctx, path.Root("timeouts"), planTimeouts)...)
+	return state.Raw.Equal(req.Plan.Raw), diags
+}
@@ -0,0 +1,80 @@
+package lavinmq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestResourceReadHonoursTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	policy := clientlibrary.DefaultRetryPolicy()
+	policy.MaxAttempts = 1
+	client := clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client(), clientlibrary.WithRetryPolicy(policy))
+	r := &queueResource{services: clientlibrary.NewServices(client)}
+
+	ctx := context.Background()
+	state := notFoundTestState(ctx, t, r)
+	if diags := state.SetAttribute(ctx, path.Root("timeouts").AtName("read"), "50ms"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	start := time.Now()
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("elapsed = %s, want the read timeout of 50ms to apply", elapsed)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected error diagnostic")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "deadline exceeded") {
+		t.Errorf("detail = %q, want deadline exceeded", detail)
+	}
+}
+
+func TestOnlyTimeoutsChanged(t *testing.T) {
+	ctx := context.Background()
+	r := &publishMessageResource{}
+	state := notFoundTestState(ctx, t, r)
+
+	timeoutsPlan := tfsdk.Plan(state)
+	if diags := timeoutsPlan.SetAttribute(ctx, path.Root("timeouts").AtName("update"), "1m"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	changed, diags := onlyTimeoutsChanged(ctx, resource.UpdateRequest{Plan: timeoutsPlan, State: state})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !changed {
+		t.Error("onlyTimeoutsChanged = false for a plan only changing timeouts")
+	}
+
+	payloadPlan := timeoutsPlan
+	if diags := payloadPlan.SetAttribute(ctx, path.Root("payload"), "changed"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	changed, diags = onlyTimeoutsChanged(ctx, resource.UpdateRequest{Plan: payloadPlan, State: state})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if changed {
+		t.Error("onlyTimeoutsChanged = true for a plan changing the payload")
+	}
+}
@@ -0,0 +1,102 @@
+package lavinmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+)
+
+// waitPollInterval is the time between status checks while waiting for a link
+// to start.
+var waitPollInterval = 2 * time.Second
+
+// waitFor calls check until it reports done, returns an error, or ctx is done.
+func waitFor(ctx context.Context, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		timer := time.NewTimer(waitPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// waitForFederationLinks waits until the upstream has at least one link and
+// all of its links in the vhost are running. A link in error status fails the
+// wait with the error reported by the broker.
+func waitForFederationLinks(ctx context.Context, services *clientlibrary.Services, vhost, upstream string) error {
+	status := "no links"
+	err := waitFor(ctx, func() (bool, error) {
+		links, err := services.FederationLinks.List(ctx, vhost)
+		if err != nil {
+			return false, err
+		}
+
+		found, running := false, true
+		for _, link := range links {
+			if link.Upstream != upstream {
+				continue
+			}
+			found = true
+			switch link.Status {
+			case "running":
+			case "error":
+				return false, fmt.Errorf("federation link of upstream %q failed: %s", upstream, link.Error)
+			default:
+				running = false
+				status = link.Status
+			}
+		}
+		return found && running, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for the federation links of upstream %q to run, last status: %s", upstream, status)
+	}
+	return err
+}
+
+// waitForShovel waits until the shovel is running. A shovel in error or
+// terminated state fails the wait with the last error reported by the broker,
+// instead of waiting for ctx to be done.
+func waitForShovel(ctx context.Context, services *clientlibrary.Services, vhost, name string) error {
+	state, lastError := "not started", ""
+	err := waitFor(ctx, func() (bool, error) {
+		shovel, err := services.Shovels.Get(ctx, vhost, name)
+		if errors.Is(err, clientlibrary.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		state, lastError = shovel.State, shovel.Error
+		switch {
+		case strings.EqualFold(state, "running"):
+			return true, nil
+		case strings.EqualFold(state, "error"), strings.EqualFold(state, "terminated"):
+			if lastError != "" {
+				return false, fmt.Errorf("shovel %q failed, state: %s, last error: %s", name, state, lastError)
+			}
+			return false, fmt.Errorf("shovel %q failed, state: %s", name, state)
+		}
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		if lastError != "" {
+			return fmt.Errorf("timed out waiting for shovel %q to run, last state: %s, last error: %s", name, state, lastError)
+		}
+		return fmt.Errorf("timed out waiting for shovel %q to run, last state: %s", name, state)
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+package lavinmq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-lavinmq/clientlibrary"
+)
+
+func newWaitTestServices(t *testing.T, responses ...string) *clientlibrary.Services {
+	t.Helper()
+	waitPollInterval = time.Millisecond
+	t.Cleanup(func() { waitPollInterval = 2 * time.Second })
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := responses[min(calls, len(responses)-1)]
+		calls++
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client := clientlibrary.NewClient(server.URL, "test", "guest", "guest", server.Client())
+	return clientlibrary.NewServices(client)
+}
+
+func TestWaitForFederationLinksRunning(t *testing.T) {
+	services := newWaitTestServices(t,
+		`[]`,
+		`[{"upstream":"upstream","vhost":"/","status":"starting"}]`,
+		`[{"upstream":"upstream","vhost":"/","status":"running"},{"upstream":"other","vhost":"/","status":"error"}]`,
+	)
+
+	if err := waitForFederationLinks(context.Background(), services, "/", "upstream"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitForFederationLinksError(t *testing.T) {
+	services := newWaitTestServices(t,
+		`[{"upstream":"upstream","vhost":"/","status":"error","error":"connection refused"}]`,
+	)
+
+	err := waitForFederationLinks(context.Background(), services, "/", "upstream")
+	if err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Fatalf("error = %v, want broker error", err)
+	}
+}
+
+func TestWaitForFederationLinksTimeout(t *testing.T) {
+	services := newWaitTestServices(t,
+		`[{"upstream":"upstream","vhost":"/","status":"starting"}]`,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := waitForFederationLinks(ctx, services, "/", "upstream")
+	if err == nil || !strings.Contains(err.Error(), "last status: starting") {
+		t.Fatalf("error = %v, want timeout", err)
+	}
+}
+
+func TestWaitForShovelRunning(t *testing.T) {
+	services := newWaitTestServices(t,
+		`[]`,
+		`[{"name":"shovel","vhost":"/","state":"Starting"}]`,
+		`[{"name":"shovel","vhost":"/","state":"Running"}]`,
+	)
+
+	if err := waitForShovel(context.Background(), services, "/", "shovel"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitForShovelTimeout(t *testing.T) {
+	services := newWaitTestServices(t,
+		`[{"name":"shovel","vhost":"/","state":"Starting","error":"connection refused"}]`,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := waitForShovel(ctx, services, "/", "shovel")
+	if err == nil || !strings.Contains(err.Error(), "timed out") || !strings.Contains(err.Error(), "last error: connection refused") {
+		t.Fatalf("error = %v, want timeout with last error", err)
+	}
+}
+
+func TestWaitForShovelFailed(t *testing.T) {
+	for _, state := range []string{"Error", "Terminated"} {
+		t.Run(state, func(t *testing.T) {
+			services := newWaitTestServices(t,
+				`[{"name":"shovel","vhost":"/","state":"Starting"}]`,
+				`[{"name":"shovel","vhost":"/","state":"`+state+`","error":"connection refused"}]`,
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			err := waitForShovel(ctx, services, "/", "shovel")
+			if err == nil || strings.Contains(err.Error(), "timed out") || !strings.Contains(err.Error(), "last error: connection refused") {
+				t.Fatalf("error = %v, want failure with last error", err)
+			}
+		})
+	}
+}